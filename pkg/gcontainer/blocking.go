@@ -0,0 +1,210 @@
+package gcontainer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ErrClosed is returned when pushing to a closed [BlockingQueue] or popping
+// from a closed and drained one.
+var ErrClosed = fmt.Errorf("queue closed")
+
+// BlockingQueue is a bounded first-in first-out queue safe for concurrent use.
+//
+// Push blocks while the queue is full and Pop blocks while it is empty; both
+// give up when their context is done. Close wakes all blocked callers: Push
+// then fails with [ErrClosed] while Pop keeps returning the remaining items
+// before failing with [ErrClosed].
+//
+// Example:
+//
+//	q := NewBlockingQueue[Job](100)
+//	go func() {
+//	    for {
+//	        job, err := q.Pop(ctx)
+//	        if err != nil {
+//	            return
+//	        }
+//	        job.Run()
+//	    }
+//	}()
+//	err := q.Push(ctx, job)
+type BlockingQueue[T any] struct {
+	mu     sync.Mutex
+	items  Deque[T]
+	closed bool
+	slots  chan struct{} // holds a token for each reserved slot
+	filled chan struct{} // holds a token for each item ready to pop
+	done   chan struct{} // closed by Close to wake blocked callers
+}
+
+// NewBlockingQueue creates a queue holding at most capacity items.
+// It panics if capacity is less than 1.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("gcontainer: invalid blocking queue capacity %d", capacity))
+	}
+	return &BlockingQueue[T]{
+		slots:  make(chan struct{}, capacity),
+		filled: make(chan struct{}, capacity),
+		done:   make(chan struct{}),
+	}
+}
+
+// Push adds an item at the back of the queue, waiting while the queue is full.
+//
+// It returns ctx.Err() if ctx is done before space is available and
+// [ErrClosed] if the queue is closed.
+func (q *BlockingQueue[T]) Push(ctx context.Context, item T) error {
+	if q.isClosed() {
+		return ErrClosed
+	}
+	select {
+	case q.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-q.done:
+		return ErrClosed
+	}
+	if !q.put(item) {
+		return ErrClosed
+	}
+	return nil
+}
+
+// TryPush adds an item at the back of the queue without waiting.
+// It reports whether the item was added.
+func (q *BlockingQueue[T]) TryPush(item T) bool {
+	if q.isClosed() {
+		return false
+	}
+	select {
+	case q.slots <- struct{}{}:
+	default:
+		return false
+	}
+	return q.put(item)
+}
+
+// Pop removes and returns the front item, waiting while the queue is empty.
+//
+// It returns ctx.Err() if ctx is done before an item is available and
+// [ErrClosed] if the queue is closed and empty.
+func (q *BlockingQueue[T]) Pop(ctx context.Context) (T, error) {
+	select {
+	case <-q.filled:
+		return q.take(), nil
+	default:
+	}
+	select {
+	case <-q.filled:
+		return q.take(), nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	case <-q.done:
+		// Items pushed before Close are still returned.
+		if v, ok := q.TryPop(); ok {
+			return v, nil
+		}
+		var zero T
+		return zero, ErrClosed
+	}
+}
+
+// TryPop removes and returns the front item without waiting. If the queue is
+// empty, TryPop returns the zero value and false.
+func (q *BlockingQueue[T]) TryPop() (T, bool) {
+	select {
+	case <-q.filled:
+		return q.take(), true
+	default:
+		var zero T
+		return zero, false
+	}
+}
+
+// Peek returns the front item without removing it. If the queue is empty,
+// Peek returns the zero value and false.
+func (q *BlockingQueue[T]) Peek() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Front()
+}
+
+// Len returns the number of items in the queue.
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+// Cap returns the maximum number of items the queue can hold.
+func (q *BlockingQueue[T]) Cap() int {
+	return cap(q.slots)
+}
+
+// Close closes the queue and wakes all blocked callers. No Push succeeds
+// after Close returns. Closing a closed queue has no effect.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		close(q.done)
+	}
+}
+
+// Range calls fn for each item from front to back on a snapshot of the
+// queue, so fn may use the queue. If fn returns false, Range stops the
+// iteration.
+func (q *BlockingQueue[T]) Range(fn func(item T) bool) {
+	for _, item := range q.ToSlice() {
+		if !fn(item) {
+			return
+		}
+	}
+}
+
+// ToSlice returns a snapshot of the items from front to back.
+func (q *BlockingQueue[T]) ToSlice() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.ToSlice()
+}
+
+func (q *BlockingQueue[T]) isClosed() bool {
+	select {
+	case <-q.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// put stores an item for which a slot was reserved and makes it available to
+// Pop. If the queue is closed, put releases the slot and returns false.
+//
+// The closed check, the store and the filled token happen under one lock
+// hold, so every item stored before Close can be popped after it.
+func (q *BlockingQueue[T]) put(item T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		<-q.slots
+		return false
+	}
+	q.items.PushBack(item)
+	q.filled <- struct{}{}
+	return true
+}
+
+// take removes an item whose filled token was received and releases its slot.
+func (q *BlockingQueue[T]) take() T {
+	q.mu.Lock()
+	item, _ := q.items.PopFront()
+	q.mu.Unlock()
+	<-q.slots
+	return item
+}
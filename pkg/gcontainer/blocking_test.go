@@ -0,0 +1,173 @@
+package gcontainer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	ctx := context.Background()
+	q := NewBlockingQueue[int](2)
+	if err := q.Push(ctx, 1); err != nil {
+		t.Fatalf("Push() error = %v", err)
+	}
+	if !q.TryPush(2) {
+		t.Fatalf("TryPush() = false, want true")
+	}
+	if q.TryPush(3) {
+		t.Errorf("TryPush() on full queue = true, want false")
+	}
+	if v, ok := q.Peek(); v != 1 || !ok {
+		t.Errorf("Peek() = %v, %v, want 1, true", v, ok)
+	}
+	if got := q.ToSlice(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("ToSlice() = %v, want [1 2]", got)
+	}
+	if q.Len() != 2 || q.Cap() != 2 {
+		t.Errorf("Len(), Cap() = %v, %v, want 2, 2", q.Len(), q.Cap())
+	}
+
+	if v, err := q.Pop(ctx); v != 1 || err != nil {
+		t.Errorf("Pop() = %v, %v, want 1, nil", v, err)
+	}
+	if v, ok := q.TryPop(); v != 2 || !ok {
+		t.Errorf("TryPop() = %v, %v, want 2, true", v, ok)
+	}
+	if v, ok := q.TryPop(); v != 0 || ok {
+		t.Errorf("TryPop() on empty = %v, %v, want 0, false", v, ok)
+	}
+}
+
+func TestBlockingQueueContext(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := q.Pop(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Pop() on empty error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	q.TryPush(1)
+	if err := q.Push(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Push() on full error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if q.Len() != 1 {
+		t.Errorf("Len() = %v, want 1", q.Len())
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	ctx := context.Background()
+	q := NewBlockingQueue[int](2)
+	q.TryPush(1)
+
+	done := make(chan error)
+	full := NewBlockingQueue[int](1)
+	full.TryPush(0)
+	go func() {
+		done <- full.Push(ctx, 1)
+	}()
+	full.Close()
+	if err := <-done; !errors.Is(err, ErrClosed) {
+		t.Errorf("blocked Push() error = %v, want %v", err, ErrClosed)
+	}
+
+	q.Close()
+	q.Close()
+	if err := q.Push(ctx, 2); !errors.Is(err, ErrClosed) {
+		t.Errorf("Push() after Close error = %v, want %v", err, ErrClosed)
+	}
+	if v, err := q.Pop(ctx); v != 1 || err != nil {
+		t.Errorf("Pop() after Close = %v, %v, want 1, nil", v, err)
+	}
+	if _, err := q.Pop(ctx); !errors.Is(err, ErrClosed) {
+		t.Errorf("Pop() on closed and empty error = %v, want %v", err, ErrClosed)
+	}
+}
+
+func TestBlockingQueueConcurrentClose(t *testing.T) {
+	ctx := context.Background()
+	for round := 0; round < 20; round++ {
+		q := NewBlockingQueue[int](1 << 16)
+		var pushed int64
+		var wg sync.WaitGroup
+		for p := 0; p < 8; p++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for q.Push(ctx, 1) == nil {
+					atomic.AddInt64(&pushed, 1)
+				}
+			}()
+		}
+		for atomic.LoadInt64(&pushed) < 100 {
+			runtime.Gosched()
+		}
+
+		q.Close()
+		n := q.Len()
+		wg.Wait()
+		if q.Len() != n {
+			t.Fatalf("round %d: %v items pushed after Close", round, q.Len()-n)
+		}
+	}
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	ctx := context.Background()
+	q := NewBlockingQueue[int](4)
+	const producers, perProducer = 4, 250
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 1; i <= perProducer; i++ {
+				if err := q.Push(ctx, i); err != nil {
+					t.Errorf("Push() error = %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	results := make(chan int)
+	for c := 0; c < 3; c++ {
+		go func() {
+			sum := 0
+			for {
+				v, err := q.Pop(ctx)
+				if err != nil {
+					results <- sum
+					return
+				}
+				sum += v
+			}
+		}()
+	}
+
+	wg.Wait()
+	q.Close()
+	total := 0
+	for c := 0; c < 3; c++ {
+		total += <-results
+	}
+	if want := producers * perProducer * (perProducer + 1) / 2; total != want {
+		t.Errorf("sum of popped items = %v, want %v", total, want)
+	}
+}
+
+func TestNewBlockingQueuePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewBlockingQueue(0) did not panic")
+		}
+	}()
+	NewBlockingQueue[int](0)
+}
@@ -0,0 +1,161 @@
+package gcontainer
+
+const minDequeCap = 8
+
+// Deque is a double-ended queue backed by a ring buffer.
+//
+// Adding and removing items at either end runs in amortized O(1). The buffer
+// grows when full and shrinks when mostly empty, and removed slots are
+// cleared. The zero value is an empty deque ready to use. Deque is not safe
+// for concurrent use.
+//
+// Example:
+//
+//	var d Deque[int]
+//	d.PushBack(2)
+//	d.PushFront(1)
+//	d.PopBack() // 2, true
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// NewDeque creates a deque containing items from front to back.
+func NewDeque[T any](items ...T) *Deque[T] {
+	d := &Deque[T]{}
+	for _, item := range items {
+		d.PushBack(item)
+	}
+	return d
+}
+
+// PushBack adds an item at the back.
+func (d *Deque[T]) PushBack(item T) {
+	d.grow()
+	d.buf[d.index(d.count)] = item
+	d.count++
+}
+
+// PushFront adds an item at the front.
+func (d *Deque[T]) PushFront(item T) {
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = item
+	d.count++
+}
+
+// PopFront removes and returns the front item. If the deque is empty,
+// PopFront returns the zero value and false.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.count == 0 {
+		return zero, false
+	}
+	item := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.count--
+	d.shrink()
+	return item, true
+}
+
+// PopBack removes and returns the back item. If the deque is empty, PopBack
+// returns the zero value and false.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.count == 0 {
+		return zero, false
+	}
+	i := d.index(d.count - 1)
+	item := d.buf[i]
+	d.buf[i] = zero
+	d.count--
+	d.shrink()
+	return item, true
+}
+
+// Front returns the front item without removing it. If the deque is empty,
+// Front returns the zero value and false.
+func (d *Deque[T]) Front() (T, bool) {
+	return d.At(0)
+}
+
+// Back returns the back item without removing it. If the deque is empty,
+// Back returns the zero value and false.
+func (d *Deque[T]) Back() (T, bool) {
+	return d.At(d.count - 1)
+}
+
+// At returns the item at position i from the front. If i is out of range,
+// At returns the zero value and false.
+func (d *Deque[T]) At(i int) (T, bool) {
+	if i < 0 || i >= d.count {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(i)], true
+}
+
+// Len returns the number of items in the deque.
+func (d *Deque[T]) Len() int {
+	return d.count
+}
+
+// Clear removes all items and releases the buffer.
+func (d *Deque[T]) Clear() {
+	*d = Deque[T]{}
+}
+
+// Range calls fn for each item from front to back.
+// If fn returns false, Range stops the iteration.
+func (d *Deque[T]) Range(fn func(item T) bool) {
+	for i := 0; i < d.count; i++ {
+		if !fn(d.buf[d.index(i)]) {
+			return
+		}
+	}
+}
+
+// ToSlice returns the items from front to back.
+func (d *Deque[T]) ToSlice() []T {
+	return collect(d.Len(), d.Range)
+}
+
+// index returns the buffer index of position i from the head.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// grow doubles the buffer if it is full.
+func (d *Deque[T]) grow() {
+	if d.count < len(d.buf) {
+		return
+	}
+	size := len(d.buf) * 2
+	if size < minDequeCap {
+		size = minDequeCap
+	}
+	d.resize(size)
+}
+
+// shrink halves the buffer if it is at most a quarter full.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minDequeCap && d.count <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+func (d *Deque[T]) resize(size int) {
+	buf := make([]T, size)
+	if d.count > 0 {
+		if d.head+d.count <= len(d.buf) {
+			copy(buf, d.buf[d.head:d.head+d.count])
+		} else {
+			n := copy(buf, d.buf[d.head:])
+			copy(buf[n:], d.buf[:d.count-n])
+		}
+	}
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,23 @@
+// Package gcontainer provides generic data structures.
+//
+// The containers avoid the memory leaks of using plain slices as queues:
+// removed elements are cleared so they can be garbage collected, and ring
+// buffers reuse and shrink their storage.
+//
+// # Containers
+//
+//   - [Stack]: last-in first-out stack
+//   - [Queue]: first-in first-out queue backed by a ring buffer
+//   - [Deque]: double-ended queue backed by a ring buffer
+//   - [PriorityQueue]: binary heap ordered by a less function such as gvalue.Less
+//   - [BlockingQueue]: bounded queue safe for concurrent use with context-aware Push and Pop
+//
+// All containers provide Len, Peek and Range. Only [BlockingQueue] is safe
+// for concurrent use.
+//
+// # Example
+//
+//	pq := gcontainer.NewPriorityQueue(gvalue.Less[int], 5, 1, 3)
+//	pq.Push(2)
+//	v, _ := pq.Pop() // 1
+package gcontainer
@@ -0,0 +1,117 @@
+package gcontainer
+
+// PriorityQueue is a binary heap that pops the item that sorts first
+// according to a less function.
+//
+// With gvalue.Less it is a min-heap and with gvalue.GT a max-heap. Push
+// and Pop run in O(log n). Items with equal priority are popped in
+// unspecified order. PriorityQueue is not safe for concurrent use.
+//
+// Example:
+//
+//	pq := NewPriorityQueue(func(a, b Task) bool { return a.Deadline.Before(b.Deadline) })
+//	pq.Push(task)
+//	next, ok := pq.Pop()
+type PriorityQueue[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewPriorityQueue creates a priority queue ordered by less and containing items.
+//
+// Building the queue from items runs in O(n).
+func NewPriorityQueue[T any](less func(a, b T) bool, items ...T) *PriorityQueue[T] {
+	pq := &PriorityQueue[T]{items: append([]T(nil), items...), less: less}
+	for i := len(pq.items)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+	return pq
+}
+
+// Push adds items to the queue.
+func (pq *PriorityQueue[T]) Push(items ...T) {
+	for _, item := range items {
+		pq.items = append(pq.items, item)
+		pq.up(len(pq.items) - 1)
+	}
+}
+
+// Pop removes and returns the first item. If the queue is empty, Pop returns
+// the zero value and false.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	var zero T
+	if len(pq.items) == 0 {
+		return zero, false
+	}
+	last := len(pq.items) - 1
+	item := pq.items[0]
+	pq.items[0] = pq.items[last]
+	pq.items[last] = zero
+	pq.items = pq.items[:last]
+	pq.down(0)
+	return item, true
+}
+
+// Peek returns the first item without removing it. If the queue is empty,
+// Peek returns the zero value and false.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.items[0], true
+}
+
+// Len returns the number of items in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// Clear removes all items.
+func (pq *PriorityQueue[T]) Clear() {
+	pq.items = nil
+}
+
+// Range calls fn for each item in unspecified order.
+// If fn returns false, Range stops the iteration.
+func (pq *PriorityQueue[T]) Range(fn func(item T) bool) {
+	for _, item := range pq.items {
+		if !fn(item) {
+			return
+		}
+	}
+}
+
+// ToSlice returns the items in unspecified order.
+func (pq *PriorityQueue[T]) ToSlice() []T {
+	return collect(pq.Len(), pq.Range)
+}
+
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.items[i], pq.items[parent]) {
+			return
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+}
+
+func (pq *PriorityQueue[T]) down(i int) {
+	for {
+		first := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(pq.items) && pq.less(pq.items[left], pq.items[first]) {
+			first = left
+		}
+		if right < len(pq.items) && pq.less(pq.items[right], pq.items[first]) {
+			first = right
+		}
+		if first == i {
+			return
+		}
+		pq.items[i], pq.items[first] = pq.items[first], pq.items[i]
+		i = first
+	}
+}
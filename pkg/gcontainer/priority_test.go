@@ -0,0 +1,96 @@
+package gcontainer
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	tests := []struct {
+		name  string
+		less  func(a, b int) bool
+		items []int
+		want  []int
+	}{
+		{
+			name:  "min heap",
+			less:  func(a, b int) bool { return a < b },
+			items: []int{5, 1, 4, 2, 3},
+			want:  []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:  "max heap",
+			less:  func(a, b int) bool { return a > b },
+			items: []int{5, 1, 4, 2, 3},
+			want:  []int{5, 4, 3, 2, 1},
+		},
+		{
+			name:  "duplicates",
+			less:  func(a, b int) bool { return a < b },
+			items: []int{2, 1, 2, 1},
+			want:  []int{1, 1, 2, 2},
+		},
+		{
+			name: "empty",
+			less: func(a, b int) bool { return a < b },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, build := range []string{"constructor", "push"} {
+				var pq *PriorityQueue[int]
+				if build == "constructor" {
+					pq = NewPriorityQueue(tt.less, tt.items...)
+				} else {
+					pq = NewPriorityQueue(tt.less)
+					pq.Push(tt.items...)
+				}
+				if pq.Len() != len(tt.items) {
+					t.Errorf("%s: Len() = %v, want %v", build, pq.Len(), len(tt.items))
+				}
+				var got []int
+				for {
+					top, _ := pq.Peek()
+					v, ok := pq.Pop()
+					if !ok {
+						break
+					}
+					if top != v {
+						t.Errorf("%s: Peek() = %v, Pop() = %v", build, top, v)
+					}
+					got = append(got, v)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("%s: Pop() order = %v, want %v", build, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPriorityQueueRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+	var want []int
+	for i := 0; i < 500; i++ {
+		v := r.Intn(100)
+		pq.Push(v)
+		want = append(want, v)
+		if r.Intn(3) == 0 {
+			sort.Ints(want)
+			got, _ := pq.Pop()
+			if got != want[0] {
+				t.Fatalf("Pop() = %v, want %v", got, want[0])
+			}
+			want = want[1:]
+		}
+	}
+	items := pq.ToSlice()
+	sort.Ints(items)
+	sort.Ints(want)
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("ToSlice() = %v, want %v", items, want)
+	}
+}
@@ -0,0 +1,63 @@
+package gcontainer
+
+// Queue is a first-in first-out queue backed by a ring buffer.
+//
+// Unlike re-slicing a plain slice, Queue clears removed items and reuses its
+// storage. The zero value is an empty queue ready to use. Queue is not safe
+// for concurrent use; see [BlockingQueue] for a concurrent queue.
+//
+// Example:
+//
+//	var q Queue[string]
+//	q.Push("a", "b")
+//	v, _ := q.Pop() // "a"
+type Queue[T any] struct {
+	d Deque[T]
+}
+
+// NewQueue creates a queue containing items, with the first item at the front.
+func NewQueue[T any](items ...T) *Queue[T] {
+	q := &Queue[T]{}
+	q.Push(items...)
+	return q
+}
+
+// Push adds items at the back of the queue in order.
+func (q *Queue[T]) Push(items ...T) {
+	for _, item := range items {
+		q.d.PushBack(item)
+	}
+}
+
+// Pop removes and returns the front item. If the queue is empty, Pop returns
+// the zero value and false.
+func (q *Queue[T]) Pop() (T, bool) {
+	return q.d.PopFront()
+}
+
+// Peek returns the front item without removing it. If the queue is empty,
+// Peek returns the zero value and false.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.d.Front()
+}
+
+// Len returns the number of items in the queue.
+func (q *Queue[T]) Len() int {
+	return q.d.Len()
+}
+
+// Clear removes all items.
+func (q *Queue[T]) Clear() {
+	q.d.Clear()
+}
+
+// Range calls fn for each item from front to back.
+// If fn returns false, Range stops the iteration.
+func (q *Queue[T]) Range(fn func(item T) bool) {
+	q.d.Range(fn)
+}
+
+// ToSlice returns the items from front to back.
+func (q *Queue[T]) ToSlice() []T {
+	return q.d.ToSlice()
+}
@@ -0,0 +1,139 @@
+package gcontainer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	q := NewQueue("a", "b")
+	q.Push("c")
+	if v, ok := q.Peek(); v != "a" || !ok {
+		t.Errorf("Peek() = %v, %v, want a, true", v, ok)
+	}
+	if got := q.ToSlice(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("ToSlice() = %v, want [a b c]", got)
+	}
+
+	var got []string
+	for q.Len() > 0 {
+		v, _ := q.Pop()
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Pop() order = %v, want [a b c]", got)
+	}
+	if v, ok := q.Pop(); v != "" || ok {
+		t.Errorf("Pop() on empty = %q, %v, want \"\", false", v, ok)
+	}
+}
+
+func TestDeque(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  func(d *Deque[int])
+		want []int
+	}{
+		{
+			name: "push back",
+			ops: func(d *Deque[int]) {
+				d.PushBack(1)
+				d.PushBack(2)
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "push front",
+			ops: func(d *Deque[int]) {
+				d.PushFront(1)
+				d.PushFront(2)
+			},
+			want: []int{2, 1},
+		},
+		{
+			name: "pop both ends",
+			ops: func(d *Deque[int]) {
+				for i := 0; i < 5; i++ {
+					d.PushBack(i)
+				}
+				d.PopFront()
+				d.PopBack()
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "wrap around and grow",
+			ops: func(d *Deque[int]) {
+				for i := 0; i < 6; i++ {
+					d.PushBack(i)
+				}
+				for i := 0; i < 4; i++ {
+					d.PopFront()
+				}
+				for i := 6; i < 20; i++ {
+					d.PushBack(i)
+				}
+				d.PushFront(3)
+			},
+			want: []int{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Deque[int]
+			tt.ops(&d)
+			if got := d.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSlice() = %v, want %v", got, tt.want)
+			}
+			if d.Len() != len(tt.want) {
+				t.Errorf("Len() = %v, want %v", d.Len(), len(tt.want))
+			}
+			if v, _ := d.Front(); v != tt.want[0] {
+				t.Errorf("Front() = %v, want %v", v, tt.want[0])
+			}
+			if v, _ := d.Back(); v != tt.want[len(tt.want)-1] {
+				t.Errorf("Back() = %v, want %v", v, tt.want[len(tt.want)-1])
+			}
+		})
+	}
+}
+
+func TestDequeEmpty(t *testing.T) {
+	var d Deque[int]
+	if v, ok := d.PopFront(); v != 0 || ok {
+		t.Errorf("PopFront() = %v, %v, want 0, false", v, ok)
+	}
+	if v, ok := d.PopBack(); v != 0 || ok {
+		t.Errorf("PopBack() = %v, %v, want 0, false", v, ok)
+	}
+	if v, ok := d.At(0); v != 0 || ok {
+		t.Errorf("At(0) = %v, %v, want 0, false", v, ok)
+	}
+}
+
+func TestDequeReleasesMemory(t *testing.T) {
+	var d Deque[*int]
+	for i := 0; i < 1000; i++ {
+		d.PushBack(new(int))
+	}
+	for i := 0; i < 998; i++ {
+		d.PopFront()
+	}
+	if len(d.buf) > minDequeCap {
+		t.Errorf("buffer size = %v, want at most %v", len(d.buf), minDequeCap)
+	}
+	nonNil := 0
+	for _, p := range d.buf {
+		if p != nil {
+			nonNil++
+		}
+	}
+	if nonNil != 2 {
+		t.Errorf("buffer holds %v pointers, want 2", nonNil)
+	}
+
+	d.Clear()
+	if d.buf != nil || d.Len() != 0 {
+		t.Errorf("Clear() left %v items in %v slots", d.Len(), len(d.buf))
+	}
+}
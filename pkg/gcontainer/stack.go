@@ -0,0 +1,86 @@
+package gcontainer
+
+// Stack is a last-in first-out stack.
+//
+// The zero value is an empty stack ready to use. Stack is not safe for
+// concurrent use.
+//
+// Example:
+//
+//	var s Stack[int]
+//	s.Push(1, 2)
+//	v, _ := s.Pop() // 2
+type Stack[T any] struct {
+	items []T
+}
+
+// NewStack creates a stack containing items, with the last item on top.
+func NewStack[T any](items ...T) *Stack[T] {
+	s := &Stack[T]{items: make([]T, 0, len(items))}
+	s.Push(items...)
+	return s
+}
+
+// Push pushes the items onto the stack in order, so the last item ends up on top.
+func (s *Stack[T]) Push(items ...T) {
+	s.items = append(s.items, items...)
+}
+
+// Pop removes and returns the top item. If the stack is empty, Pop returns
+// the zero value and false.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	last := len(s.items) - 1
+	v := s.items[last]
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return v, true
+}
+
+// Peek returns the top item without removing it. If the stack is empty,
+// Peek returns the zero value and false.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of items in the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Clear removes all items.
+func (s *Stack[T]) Clear() {
+	s.items = nil
+}
+
+// Range calls fn for each item from the top to the bottom of the stack.
+// If fn returns false, Range stops the iteration.
+func (s *Stack[T]) Range(fn func(item T) bool) {
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if !fn(s.items[i]) {
+			return
+		}
+	}
+}
+
+// ToSlice returns the items from the top to the bottom of the stack.
+func (s *Stack[T]) ToSlice() []T {
+	return collect(s.Len(), s.Range)
+}
+
+// collect returns the items visited by rangeFn.
+func collect[T any](n int, rangeFn func(func(T) bool)) []T {
+	result := make([]T, 0, n)
+	rangeFn(func(item T) bool {
+		result = append(result, item)
+		return true
+	})
+	return result
+}
@@ -0,0 +1,55 @@
+package gcontainer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+	if v, ok := s.Pop(); v != 0 || ok {
+		t.Errorf("Pop() on empty = %v, %v, want 0, false", v, ok)
+	}
+	if v, ok := s.Peek(); v != 0 || ok {
+		t.Errorf("Peek() on empty = %v, %v, want 0, false", v, ok)
+	}
+
+	s.Push(1, 2, 3)
+	if v, ok := s.Peek(); v != 3 || !ok {
+		t.Errorf("Peek() = %v, %v, want 3, true", v, ok)
+	}
+	if got := s.ToSlice(); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("ToSlice() = %v, want [3 2 1]", got)
+	}
+	if v, ok := s.Pop(); v != 3 || !ok {
+		t.Errorf("Pop() = %v, %v, want 3, true", v, ok)
+	}
+	if s.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", s.Len())
+	}
+
+	s.Clear()
+	if s.Len() != 0 {
+		t.Errorf("Len() after Clear = %v, want 0", s.Len())
+	}
+}
+
+func TestStackClearsPopped(t *testing.T) {
+	s := NewStack(new(int), new(int))
+	s.Pop()
+	if backing := s.items[:2]; backing[1] != nil {
+		t.Errorf("popped slot = %v, want nil", backing[1])
+	}
+}
+
+func TestStackRange(t *testing.T) {
+	s := NewStack(1, 2, 3, 4)
+	var got []int
+	s.Range(func(v int) bool {
+		got = append(got, v)
+		return v > 3
+	})
+	if !reflect.DeepEqual(got, []int{4, 3}) {
+		t.Errorf("Range() visited %v, want [4 3]", got)
+	}
+}
@@ -0,0 +1,41 @@
+// Package giter provides lazy, composable iterators with generics support.
+//
+// Unlike the functions in gslice, which allocate a full intermediate slice at
+// every step, giter operations are evaluated lazily: elements flow through
+// the whole pipeline one at a time, and terminal operations such as [Find],
+// [Any] and [All] stop pulling elements as soon as the result is known.
+//
+// [Seq] and [Seq2] have the same shape as iter.Seq and iter.Seq2 from Go 1.23,
+// so they can be used directly in range-over-func loops on Go 1.23 and later.
+//
+// # Basic Usage
+//
+//	names := giter.Collect(
+//	    giter.Map(
+//	        giter.Filter(giter.FromSlice(users), func(u User) bool { return u.Active }),
+//	        func(u User) string { return u.Name },
+//	    ),
+//	)
+//
+// # Sources
+//
+//   - [FromSlice]: iterates over the elements of a slice
+//   - [FromMap]: iterates over the key-value pairs of a map
+//   - [FromChan]: iterates over the values received from a channel
+//   - [Of]: iterates over the given values
+//
+// # Operations
+//
+//   - [Map], [Filter], [FlatMap]: transform and select elements
+//   - [Take], [Skip], [TakeWhile], [DropWhile]: limit the sequence
+//   - [Chunk]: groups elements into slices
+//   - [Zip]: pairs the elements of two sequences
+//   - [Keys], [Values]: project a [Seq2] onto one side
+//
+// # Terminal Operations
+//
+//   - [Collect], [CollectMap]: gather elements into a slice or a map
+//   - [Reduce]: reduces the sequence to a single value
+//   - [Find], [Any], [All]: short-circuit searches
+//   - [Count], [ForEach]: consume the sequence
+package giter
@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package giter
+
+import "iter"
+
+// FromIter returns a [Seq] backed by the standard library iterator seq.
+func FromIter[T any](seq iter.Seq[T]) Seq[T] {
+	return Seq[T](seq)
+}
+
+// FromIter2 returns a [Seq2] backed by the standard library iterator seq.
+func FromIter2[K, V any](seq iter.Seq2[K, V]) Seq2[K, V] {
+	return Seq2[K, V](seq)
+}
+
+// Iter returns s as a standard library iterator.
+func (s Seq[T]) Iter() iter.Seq[T] {
+	return iter.Seq[T](s)
+}
+
+// Iter returns s as a standard library iterator.
+func (s Seq2[K, V]) Iter() iter.Seq2[K, V] {
+	return iter.Seq2[K, V](s)
+}
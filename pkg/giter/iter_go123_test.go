@@ -0,0 +1,34 @@
+//go:build go1.23
+
+package giter
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestStdlibIter(t *testing.T) {
+	var result []int
+	for v := range Filter(Of(1, 2, 3, 4), func(n int) bool { return n%2 == 0 }) {
+		result = append(result, v)
+	}
+	if !reflect.DeepEqual(result, []int{2, 4}) {
+		t.Errorf("range over Seq = %v, want [2 4]", result)
+	}
+
+	doubled := slices.Collect(Map(FromIter(slices.Values([]int{1, 2})), func(n int) int { return n * 2 }).Iter())
+	if !reflect.DeepEqual(doubled, []int{2, 4}) {
+		t.Errorf("FromIter()/Iter() = %v, want [2 4]", doubled)
+	}
+
+	keys := Collect(Keys(FromIter2(slices.All([]string{"a", "b"}))))
+	if !reflect.DeepEqual(keys, []int{0, 1}) {
+		t.Errorf("FromIter2() keys = %v, want [0 1]", keys)
+	}
+	for k, v := range Zip(Of("a"), Of(1)).Iter() {
+		if k != "a" || v != 1 {
+			t.Errorf("Seq2.Iter() = %v, %v, want a, 1", k, v)
+		}
+	}
+}
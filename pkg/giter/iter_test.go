@@ -0,0 +1,289 @@
+package giter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Seq[int]
+		expected []int
+	}{
+		{
+			name:     "from slice",
+			input:    FromSlice([]int{1, 2, 3}),
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "of",
+			input:    Of(4, 5),
+			expected: []int{4, 5},
+		},
+		{
+			name:     "nil slice",
+			input:    FromSlice[int](nil),
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Collect(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Collect() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOperations(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	lessThan := func(m int) func(int) bool { return func(n int) bool { return n < m } }
+
+	tests := []struct {
+		name     string
+		seq      Seq[int]
+		expected []int
+	}{
+		{
+			name:     "map",
+			seq:      Map(Of(1, 2, 3), func(n int) int { return n * 10 }),
+			expected: []int{10, 20, 30},
+		},
+		{
+			name:     "filter",
+			seq:      Filter(Of(1, 2, 3, 4), isEven),
+			expected: []int{2, 4},
+		},
+		{
+			name:     "flat map",
+			seq:      FlatMap(Of(1, 2, 3), func(n int) Seq[int] { return Take(Of(n, n, n), n) }),
+			expected: []int{1, 2, 2, 3, 3, 3},
+		},
+		{
+			name:     "take",
+			seq:      Take(Of(1, 2, 3), 2),
+			expected: []int{1, 2},
+		},
+		{
+			name:     "take more than length",
+			seq:      Take(Of(1, 2), 5),
+			expected: []int{1, 2},
+		},
+		{
+			name:     "take zero",
+			seq:      Take(Of(1, 2), 0),
+			expected: nil,
+		},
+		{
+			name:     "skip",
+			seq:      Skip(Of(1, 2, 3), 2),
+			expected: []int{3},
+		},
+		{
+			name:     "take while",
+			seq:      TakeWhile(Of(1, 2, 3, 1), lessThan(3)),
+			expected: []int{1, 2},
+		},
+		{
+			name:     "drop while",
+			seq:      DropWhile(Of(1, 2, 3, 1), lessThan(3)),
+			expected: []int{3, 1},
+		},
+		{
+			name:     "keys",
+			seq:      Keys(Zip(Of(1, 2, 3), Of("a", "b"))),
+			expected: []int{1, 2},
+		},
+		{
+			name:     "values",
+			seq:      Values(Zip(Of("a", "b", "c"), Of(7, 8, 9))),
+			expected: []int{7, 8, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Collect(tt.seq)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("%s = %v, want %v", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLaziness(t *testing.T) {
+	pulled := 0
+	source := Map(Of(1, 2, 3, 4, 5, 6), func(n int) int {
+		pulled++
+		return n
+	})
+
+	value, found := Find(Filter(source, func(n int) bool { return n%2 == 0 }), func(n int) bool { return n > 2 })
+	if !found || value != 4 {
+		t.Errorf("Find() = %v, %v, want 4, true", value, found)
+	}
+	if pulled != 4 {
+		t.Errorf("Find() pulled %v elements, want 4", pulled)
+	}
+
+	pulled = 0
+	if !Any(source, func(n int) bool { return n == 2 }) || pulled != 2 {
+		t.Errorf("Any() pulled %v elements, want 2", pulled)
+	}
+
+	pulled = 0
+	if All(source, func(n int) bool { return n < 3 }) || pulled != 3 {
+		t.Errorf("All() pulled %v elements, want 3", pulled)
+	}
+
+	pulled = 0
+	Collect(Take(source, 2))
+	if pulled != 2 {
+		t.Errorf("Take() pulled %v elements, want 2", pulled)
+	}
+}
+
+func TestTerminal(t *testing.T) {
+	if sum := Reduce(Of(1, 2, 3, 4), 0, func(acc, n int) int { return acc + n }); sum != 10 {
+		t.Errorf("Reduce() = %v, want 10", sum)
+	}
+	if _, found := Find(Of[int](), func(int) bool { return true }); found {
+		t.Errorf("Find() on empty sequence should not find anything")
+	}
+	if Any(Of[int](), func(int) bool { return true }) {
+		t.Errorf("Any() on empty sequence should be false")
+	}
+	if !All(Of[int](), func(int) bool { return false }) {
+		t.Errorf("All() on empty sequence should be true")
+	}
+	if count := Count(Filter(Of(1, 2, 3), func(n int) bool { return n > 1 })); count != 2 {
+		t.Errorf("Count() = %v, want 2", count)
+	}
+
+	var visited []string
+	ForEach(Of("a", "b"), func(s string) { visited = append(visited, s) })
+	if !reflect.DeepEqual(visited, []string{"a", "b"}) {
+		t.Errorf("ForEach() visited %v, want [a b]", visited)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "uneven",
+			input:    []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "even",
+			input:    []int{1, 2, 3, 4},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			size:     3,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Collect(Chunk(FromSlice(tt.input), tt.size))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Chunk() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	first := Collect(Take(Chunk(Of(1, 2, 3, 4), 2), 1))
+	if !reflect.DeepEqual(first, [][]int{{1, 2}}) {
+		t.Errorf("Chunk() with early stop = %v, want [[1 2]]", first)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Chunk() with size 0 should panic")
+		}
+	}()
+	Chunk(Of(1), 0)
+}
+
+func TestZip(t *testing.T) {
+	result := CollectMap(Zip(Of("a", "b", "c"), Of(1, 2)))
+	if !reflect.DeepEqual(result, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("Zip() = %v, want map[a:1 b:2]", result)
+	}
+
+	pulled := 0
+	infinite := Seq[int](func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+	keys := Collect(Keys(Zip(Of("x", "y"), infinite)))
+	if !reflect.DeepEqual(keys, []string{"x", "y"}) {
+		t.Errorf("Zip() with infinite sequence = %v, want [x y]", keys)
+	}
+}
+
+func TestZipPanic(t *testing.T) {
+	failing := Seq[int](func(yield func(int) bool) {
+		if yield(1) {
+			panic("boom")
+		}
+	})
+
+	var got []string
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Zip() panic = %v, want boom", r)
+		}
+		if !reflect.DeepEqual(got, []string{"a"}) {
+			t.Errorf("Zip() = %v, want [a]", got)
+		}
+	}()
+	Zip(Of("a", "b", "c"), failing)(func(s string, _ int) bool {
+		got = append(got, s)
+		return true
+	})
+	t.Error("Zip() did not panic")
+}
+
+func TestAdapters(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	if result := CollectMap(FromMap(m)); !reflect.DeepEqual(result, m) {
+		t.Errorf("FromMap() = %v, want %v", result, m)
+	}
+
+	ch := make(chan string, 3)
+	ch <- "x"
+	ch <- "y"
+	ch <- "z"
+	close(ch)
+	if result := Collect(Take(FromChan(ch), 2)); !reflect.DeepEqual(result, []string{"x", "y"}) {
+		t.Errorf("FromChan() = %v, want [x y]", result)
+	}
+	if result := Collect(FromChan(ch)); !reflect.DeepEqual(result, []string{"z"}) {
+		t.Errorf("FromChan() remaining = %v, want [z]", result)
+	}
+
+	words := Collect(FlatMap(Of("a b", "c"), func(s string) Seq[string] { return FromSlice(strings.Fields(s)) }))
+	if !reflect.DeepEqual(words, []string{"a", "b", "c"}) {
+		t.Errorf("FlatMap() = %v, want [a b c]", words)
+	}
+}
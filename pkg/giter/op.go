@@ -0,0 +1,233 @@
+package giter
+
+// Map returns a [Seq] that applies fn to each element of seq.
+//
+// Example:
+//
+//	doubled := Map(Of(1, 2, 3), func(n int) int { return n * 2 })
+//	// 2, 4, 6
+func Map[T, R any](seq Seq[T], fn func(T) R) Seq[R] {
+	return func(yield func(R) bool) {
+		seq(func(v T) bool {
+			return yield(fn(v))
+		})
+	}
+}
+
+// Filter returns a [Seq] with the elements of seq that satisfy the predicate.
+//
+// Example:
+//
+//	evens := Filter(Of(1, 2, 3, 4), func(n int) bool { return n%2 == 0 })
+//	// 2, 4
+func Filter[T any](seq Seq[T], fn func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			if !fn(v) {
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// FlatMap returns a [Seq] that applies fn to each element of seq and yields
+// the elements of the resulting sequences in order.
+//
+// Example:
+//
+//	words := FlatMap(Of("a b", "c"), func(s string) Seq[string] {
+//	    return FromSlice(strings.Fields(s))
+//	})
+//	// "a", "b", "c"
+func FlatMap[T, R any](seq Seq[T], fn func(T) Seq[R]) Seq[R] {
+	return func(yield func(R) bool) {
+		seq(func(v T) bool {
+			ok := true
+			fn(v)(func(r R) bool {
+				ok = yield(r)
+				return ok
+			})
+			return ok
+		})
+	}
+}
+
+// Take returns a [Seq] with at most the first n elements of seq.
+//
+// Take stops pulling from seq once n elements have been yielded.
+func Take[T any](seq Seq[T], n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		seq(func(v T) bool {
+			count++
+			return yield(v) && count < n
+		})
+	}
+}
+
+// Skip returns a [Seq] without the first n elements of seq.
+func Skip[T any](seq Seq[T], n int) Seq[T] {
+	return func(yield func(T) bool) {
+		count := 0
+		seq(func(v T) bool {
+			if count < n {
+				count++
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// TakeWhile returns a [Seq] with the leading elements of seq that satisfy the
+// predicate. It stops at the first element that does not.
+func TakeWhile[T any](seq Seq[T], fn func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			return fn(v) && yield(v)
+		})
+	}
+}
+
+// DropWhile returns a [Seq] that skips the leading elements of seq that
+// satisfy the predicate and yields the rest.
+func DropWhile[T any](seq Seq[T], fn func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		seq(func(v T) bool {
+			if dropping && fn(v) {
+				return true
+			}
+			dropping = false
+			return yield(v)
+		})
+	}
+}
+
+// Chunk returns a [Seq] of consecutive slices with up to size elements of seq.
+//
+// The last chunk may be shorter than size. Each chunk is a new slice, so
+// callers can keep or modify it. Chunk panics if size is less than 1.
+//
+// Example:
+//
+//	chunks := Collect(Chunk(Of(1, 2, 3, 4, 5), 2))
+//	// [][]int{{1, 2}, {3, 4}, {5}}
+func Chunk[T any](seq Seq[T], size int) Seq[[]T] {
+	if size < 1 {
+		panic("giter: chunk size must be positive")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		stopped := false
+		seq(func(v T) bool {
+			chunk = append(chunk, v)
+			if len(chunk) < size {
+				return true
+			}
+			if !yield(chunk) {
+				stopped = true
+				return false
+			}
+			chunk = make([]T, 0, size)
+			return true
+		})
+		if !stopped && len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip returns a [Seq2] that pairs the elements of a and b in order.
+//
+// The sequence ends when either input ends. Zip consumes b from a separate
+// goroutine, which exits as soon as the returned sequence stops. A panic
+// in b is recovered there and raised again in the goroutine ranging over
+// the result.
+//
+// Example:
+//
+//	CollectMap(Zip(Of("a", "b"), Of(1, 2, 3)))
+//	// map[string]int{"a": 1, "b": 2}
+func Zip[A, B any](a Seq[A], b Seq[B]) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := pull(b)
+		defer stop()
+		a(func(va A) bool {
+			vb, ok := next()
+			if !ok {
+				return false
+			}
+			return yield(va, vb)
+		})
+	}
+}
+
+// Keys returns a [Seq] with the keys of seq.
+func Keys[K, V any](seq Seq2[K, V]) Seq[K] {
+	return func(yield func(K) bool) {
+		seq(func(k K, _ V) bool {
+			return yield(k)
+		})
+	}
+}
+
+// Values returns a [Seq] with the values of seq.
+func Values[K, V any](seq Seq2[K, V]) Seq[V] {
+	return func(yield func(V) bool) {
+		seq(func(_ K, v V) bool {
+			return yield(v)
+		})
+	}
+}
+
+// pull converts a push sequence into a pull function backed by a goroutine.
+// stop must be called to release the goroutine. If seq panics, next panics
+// with the same value instead of crashing the process.
+func pull[T any](seq Seq[T]) (next func() (T, bool), stop func()) {
+	values := make(chan T)
+	done := make(chan struct{})
+	var (
+		panicked bool
+		panicVal any
+	)
+	go func() {
+		defer close(values)
+		returned := false
+		defer func() {
+			if !returned {
+				panicked, panicVal = true, recover()
+			}
+		}()
+		seq(func(v T) bool {
+			select {
+			case values <- v:
+				return true
+			case <-done:
+				return false
+			}
+		})
+		returned = true
+	}()
+
+	stopped := false
+	next = func() (T, bool) {
+		v, ok := <-values
+		if !ok && panicked {
+			panicked = false
+			panic(panicVal)
+		}
+		return v, ok
+	}
+	stop = func() {
+		if !stopped {
+			stopped = true
+			close(done)
+		}
+	}
+	return next, stop
+}
@@ -0,0 +1,63 @@
+package giter
+
+// Seq is a lazy sequence of values.
+//
+// A Seq calls yield for each element in order and stops as soon as yield
+// returns false. Seq has the same underlying type as iter.Seq, so on Go 1.23
+// and later it can be used in a range-over-func loop.
+type Seq[T any] func(yield func(T) bool)
+
+// Seq2 is a lazy sequence of key-value pairs.
+//
+// Seq2 has the same underlying type as iter.Seq2.
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// FromSlice returns a [Seq] over the elements of s.
+//
+// The slice is not copied, so changes to s made before the sequence is
+// consumed are visible to it.
+//
+// Example:
+//
+//	seq := FromSlice([]int{1, 2, 3})
+//	Collect(seq) // []int{1, 2, 3}
+func FromSlice[T any](s []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Of returns a [Seq] over the given values.
+func Of[T any](values ...T) Seq[T] {
+	return FromSlice(values)
+}
+
+// FromMap returns a [Seq2] over the key-value pairs of m.
+//
+// The iteration order is unspecified, as for a range loop over a map.
+func FromMap[K comparable, V any](m map[K]V) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FromChan returns a [Seq] over the values received from ch until it is closed.
+//
+// If the consumer stops early, the remaining values stay in the channel.
+func FromChan[T any](ch <-chan T) Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package giter
+
+// Collect gathers the elements of seq into a new slice.
+//
+// Collect returns nil if seq yields no elements.
+//
+// Example:
+//
+//	Collect(Of(1, 2, 3)) // []int{1, 2, 3}
+func Collect[T any](seq Seq[T]) []T {
+	var result []T
+	seq(func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return result
+}
+
+// CollectMap gathers the key-value pairs of seq into a new map.
+//
+// Later pairs overwrite earlier pairs with the same key.
+func CollectMap[K comparable, V any](seq Seq2[K, V]) map[K]V {
+	result := make(map[K]V)
+	seq(func(k K, v V) bool {
+		result[k] = v
+		return true
+	})
+	return result
+}
+
+// Reduce reduces seq to a single value by applying fn cumulatively,
+// starting from initial.
+//
+// Example:
+//
+//	sum := Reduce(Of(1, 2, 3, 4), 0, func(acc, n int) int { return acc + n })
+//	// sum is 10
+func Reduce[T, R any](seq Seq[T], initial R, fn func(R, T) R) R {
+	result := initial
+	seq(func(v T) bool {
+		result = fn(result, v)
+		return true
+	})
+	return result
+}
+
+// Find returns the first element of seq that satisfies the predicate, along
+// with a boolean indicating whether such an element was found.
+//
+// Find stops pulling from seq as soon as a match is found.
+func Find[T any](seq Seq[T], fn func(T) bool) (T, bool) {
+	var result T
+	found := false
+	seq(func(v T) bool {
+		if fn(v) {
+			result, found = v, true
+			return false
+		}
+		return true
+	})
+	return result, found
+}
+
+// Any reports whether at least one element of seq satisfies the predicate.
+//
+// Any stops pulling from seq at the first match and returns false for an
+// empty sequence.
+func Any[T any](seq Seq[T], fn func(T) bool) bool {
+	_, found := Find(seq, fn)
+	return found
+}
+
+// All reports whether every element of seq satisfies the predicate.
+//
+// All stops pulling from seq at the first mismatch and returns true for an
+// empty sequence (vacuous truth).
+func All[T any](seq Seq[T], fn func(T) bool) bool {
+	return !Any(seq, func(v T) bool { return !fn(v) })
+}
+
+// Count consumes seq and returns the number of elements.
+func Count[T any](seq Seq[T]) int {
+	count := 0
+	seq(func(T) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// ForEach calls fn for each element of seq.
+func ForEach[T any](seq Seq[T], fn func(T)) {
+	seq(func(v T) bool {
+		fn(v)
+		return true
+	})
+}
@@ -0,0 +1,413 @@
+package gjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+// CompareOption is a function that configures JSON comparison behavior.
+// Use the With* functions to create CompareOption values.
+type CompareOption func(opt _option) _option
+
+// WithIgnoreKeyOrder configures whether the order of object keys matters.
+//
+// Object key order is ignored by default, as JSON objects are unordered.
+// Set ignore to false to report objects whose keys appear in a different order.
+func WithIgnoreKeyOrder(ignore bool) CompareOption {
+	return func(opt _option) _option {
+		opt.IgnoreKeyOrder = gvalue.Ptr(ignore)
+		return opt
+	}
+}
+
+// WithIgnoreArrayOrder configures whether the order of array elements matters.
+//
+// Array order matters by default. Set ignore to true to treat arrays as
+// multisets, so that arrays with the same elements in a different order are equal.
+//
+// Example:
+//
+//	Equal(`[1,2,3]`, `[3,1,2]`, WithIgnoreArrayOrder(true)) // true
+func WithIgnoreArrayOrder(ignore bool) CompareOption {
+	return func(opt _option) _option {
+		opt.IgnoreArrayOrder = gvalue.Ptr(ignore)
+		return opt
+	}
+}
+
+// WithNumericTolerance configures the comparison to treat numbers as equal
+// when their absolute difference is at most tolerance.
+//
+// Example:
+//
+//	Equal(`{"pi":3.14159}`, `{"pi":3.1416}`, WithNumericTolerance(0.001)) // true
+func WithNumericTolerance(tolerance float64) CompareOption {
+	return func(opt _option) _option {
+		opt.NumericTolerance = gvalue.Ptr(tolerance)
+		return opt
+	}
+}
+
+// WithIgnoredPaths configures the comparison to skip values matched by the
+// given path patterns, such as timestamps or generated IDs.
+//
+// Patterns use the same syntax as [RedactRule]:
+//
+//	Equal(a, b, WithIgnoredPaths("updated_at", "items.*.id"))
+func WithIgnoredPaths(patterns ...string) CompareOption {
+	return func(opt _option) _option {
+		opt.IgnoredPaths = append(append([]string(nil), opt.IgnoredPaths...), patterns...)
+		return opt
+	}
+}
+
+// DiffKind describes how two JSON values differ.
+type DiffKind string
+
+const (
+	// DiffAdded means the value only exists in the right document.
+	DiffAdded DiffKind = "added"
+	// DiffRemoved means the value only exists in the left document.
+	DiffRemoved DiffKind = "removed"
+	// DiffChanged means the value exists in both documents with different content.
+	DiffChanged DiffKind = "changed"
+	// DiffKeyOrder means an object has the same keys in a different order.
+	DiffKeyOrder DiffKind = "key order"
+)
+
+// Difference describes a single difference reported by [Compare].
+type Difference struct {
+	// Path is the gjson path of the value, empty for the document root.
+	Path string
+	Kind DiffKind
+	// Left and Right are the raw JSON values, empty when the value is missing.
+	Left  string
+	Right string
+}
+
+// String returns a human-readable description of the difference.
+func (d Difference) String() string {
+	path := d.Path
+	if path == "" {
+		path = "(root)"
+	}
+	switch d.Kind {
+	case DiffAdded:
+		return fmt.Sprintf("`%s` added: %s", path, d.Right)
+	case DiffRemoved:
+		return fmt.Sprintf("`%s` removed: %s", path, d.Left)
+	case DiffKeyOrder:
+		return fmt.Sprintf("`%s` key order: %s != %s", path, d.Left, d.Right)
+	default:
+		return fmt.Sprintf("`%s` changed: %s != %s", path, d.Left, d.Right)
+	}
+}
+
+// Equal reports whether a and b hold semantically equal JSON documents.
+//
+// Object key order is ignored and numbers are compared by value, so
+// `{"a":1.0,"b":2}` equals `{"b":2,"a":1}`. Invalid JSON is never equal
+// to anything. Use [CompareOption] values to relax the comparison further.
+//
+// Example:
+//
+//	Equal(`{"a":1,"b":[1,2]}`, `{"b":[1,2],"a":1.0}`) // true
+func Equal[A, B ~[]byte | ~string](a A, b B, opts ...CompareOption) bool {
+	diffs, err := Compare(a, b, opts...)
+	return err == nil && len(diffs) == 0
+}
+
+// Compare returns the differences between the JSON documents a and b.
+//
+// The comparison rules are the same as [Equal]. An empty result means the
+// documents are equal. Returns [ErrInvalidJSON] if either document is invalid.
+//
+// Example:
+//
+//	diffs, _ := Compare(`{"a":1,"b":2}`, `{"a":2,"c":3}`)
+//	for _, d := range diffs {
+//	    fmt.Println(d)
+//	}
+//	// `a` changed: 1 != 2
+//	// `b` removed: 2
+//	// `c` added: 3
+func Compare[A, B ~[]byte | ~string](a A, b B, opts ...CompareOption) ([]Difference, error) {
+	left, right := []byte(a), []byte(b)
+	if !gjson.ValidBytes(left) || !gjson.ValidBytes(right) {
+		return nil, ErrInvalidJSON
+	}
+
+	var opt _option
+	for _, fn := range opts {
+		opt = fn(opt)
+	}
+	c := &comparer{
+		ignoreKeyOrder:   opt.IgnoreKeyOrder == nil || *opt.IgnoreKeyOrder,
+		ignoreArrayOrder: opt.IgnoreArrayOrder != nil && *opt.IgnoreArrayOrder,
+	}
+	if opt.NumericTolerance != nil {
+		c.tolerance = *opt.NumericTolerance
+	}
+	for _, pattern := range opt.IgnoredPaths {
+		c.ignored = append(c.ignored, compilePattern(pattern))
+	}
+	c.compare(gjson.ParseBytes(left), gjson.ParseBytes(right), nil)
+	return c.diffs, nil
+}
+
+// ShouldMatchJSON is a goconvey-style assertion that passes when actual and
+// expected are semantically equal JSON documents.
+//
+// Both values may be JSON strings, byte slices, [json.RawMessage] or any
+// other Go value, which is marshaled first. Additional [CompareOption] values
+// can follow the expected value.
+//
+// Example:
+//
+//	So(body, gjson.ShouldMatchJSON, `{"id":1,"name":"John"}`)
+//	So(body, gjson.ShouldMatchJSON, expected, gjson.WithIgnoredPaths("created_at"))
+func ShouldMatchJSON(actual any, expected ...any) string {
+	if len(expected) == 0 {
+		return "This assertion requires an expected JSON value (you provided none)."
+	}
+	var opts []CompareOption
+	for _, v := range expected[1:] {
+		opt, ok := v.(CompareOption)
+		if !ok {
+			return fmt.Sprintf("Expected CompareOption values after the expected JSON (got %T).", v)
+		}
+		opts = append(opts, opt)
+	}
+
+	left, err := assertionJSON(actual)
+	if err != nil {
+		return fmt.Sprintf("Expected actual value to be JSON (%v).", err)
+	}
+	right, err := assertionJSON(expected[0])
+	if err != nil {
+		return fmt.Sprintf("Expected expected value to be JSON (%v).", err)
+	}
+	diffs, err := Compare(left, right, opts...)
+	if err != nil {
+		return fmt.Sprintf("Expected valid JSON (%v).", err)
+	}
+	if len(diffs) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(diffs)+1)
+	lines = append(lines, fmt.Sprintf("Expected JSON documents to be equal, but found %d difference(s):", len(diffs)))
+	for _, d := range diffs {
+		lines = append(lines, "  "+d.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func assertionJSON(v any) ([]byte, error) {
+	switch data := v.(type) {
+	case string:
+		return []byte(data), nil
+	case []byte:
+		return data, nil
+	case json.RawMessage:
+		return data, nil
+	default:
+		return Marshal[[]byte](v)
+	}
+}
+
+type comparer struct {
+	ignoreKeyOrder   bool
+	ignoreArrayOrder bool
+	tolerance        float64
+	ignored          [][]string
+	diffs            []Difference
+}
+
+func (c *comparer) report(path []string, kind DiffKind, left, right string) {
+	c.diffs = append(c.diffs, Difference{
+		Path:  formatPath(path),
+		Kind:  kind,
+		Left:  left,
+		Right: right,
+	})
+}
+
+func (c *comparer) isIgnored(path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+	for _, pattern := range c.ignored {
+		if matchSegments(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *comparer) compare(l, r gjson.Result, path []string) {
+	if c.isIgnored(path) {
+		return
+	}
+	switch {
+	case l.IsObject() && r.IsObject():
+		c.compareObjects(l, r, path)
+	case l.IsArray() && r.IsArray():
+		if c.ignoreArrayOrder {
+			c.compareUnordered(l, r, path)
+		} else {
+			c.compareOrdered(l, r, path)
+		}
+	case !c.equalScalars(l, r):
+		c.report(path, DiffChanged, l.Raw, r.Raw)
+	}
+}
+
+func (c *comparer) equalScalars(l, r gjson.Result) bool {
+	if l.Type != r.Type {
+		return false
+	}
+	switch l.Type {
+	case gjson.Number:
+		li, lErr := strconv.ParseInt(l.Raw, 10, 64)
+		ri, rErr := strconv.ParseInt(r.Raw, 10, 64)
+		if lErr == nil && rErr == nil && c.tolerance == 0 {
+			return li == ri
+		}
+		return math.Abs(l.Float()-r.Float()) <= c.tolerance
+	case gjson.String:
+		return l.String() == r.String()
+	default:
+		return l.Raw == r.Raw
+	}
+}
+
+func (c *comparer) compareObjects(l, r gjson.Result, path []string) {
+	lKeys, lValues := objectEntries(l)
+	rKeys, rValues := objectEntries(r)
+
+	for _, key := range lKeys {
+		keyPath := appendPath(path, key)
+		if right, ok := rValues[key]; ok {
+			c.compare(lValues[key], right, keyPath)
+		} else if !c.isIgnored(keyPath) {
+			c.report(keyPath, DiffRemoved, lValues[key].Raw, "")
+		}
+	}
+	for _, key := range rKeys {
+		keyPath := appendPath(path, key)
+		if _, ok := lValues[key]; !ok && !c.isIgnored(keyPath) {
+			c.report(keyPath, DiffAdded, "", rValues[key].Raw)
+		}
+	}
+
+	if c.ignoreKeyOrder || len(lKeys) != len(rKeys) {
+		return
+	}
+	for i := range lKeys {
+		if lKeys[i] != rKeys[i] {
+			c.report(path, DiffKeyOrder, quoteKeys(lKeys), quoteKeys(rKeys))
+			return
+		}
+	}
+}
+
+func (c *comparer) compareOrdered(l, r gjson.Result, path []string) {
+	lElems, rElems := l.Array(), r.Array()
+	for i := 0; i < len(lElems) || i < len(rElems); i++ {
+		elemPath := appendPath(path, strconv.Itoa(i))
+		switch {
+		case i >= len(rElems):
+			if !c.isIgnored(elemPath) {
+				c.report(elemPath, DiffRemoved, lElems[i].Raw, "")
+			}
+		case i >= len(lElems):
+			if !c.isIgnored(elemPath) {
+				c.report(elemPath, DiffAdded, "", rElems[i].Raw)
+			}
+		default:
+			c.compare(lElems[i], rElems[i], elemPath)
+		}
+	}
+}
+
+// compareUnordered matches every left element with an equal unmatched right
+// element. Unmatched elements are reported as removed or added.
+func (c *comparer) compareUnordered(l, r gjson.Result, path []string) {
+	lElems, rElems := l.Array(), r.Array()
+	matched := make([]bool, len(rElems))
+	for i, left := range lElems {
+		elemPath := appendPath(path, strconv.Itoa(i))
+		if c.isIgnored(elemPath) {
+			continue
+		}
+		found := false
+		for j, right := range rElems {
+			if matched[j] {
+				continue
+			}
+			sub := &comparer{
+				ignoreKeyOrder:   c.ignoreKeyOrder,
+				ignoreArrayOrder: c.ignoreArrayOrder,
+				tolerance:        c.tolerance,
+				ignored:          c.ignored,
+			}
+			sub.compare(left, right, elemPath)
+			if len(sub.diffs) == 0 {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.report(elemPath, DiffRemoved, left.Raw, "")
+		}
+	}
+	for j, right := range rElems {
+		elemPath := appendPath(path, strconv.Itoa(j))
+		if !matched[j] && !c.isIgnored(elemPath) {
+			c.report(elemPath, DiffAdded, "", right.Raw)
+		}
+	}
+}
+
+func objectEntries(obj gjson.Result) ([]string, map[string]gjson.Result) {
+	var keys []string
+	values := make(map[string]gjson.Result)
+	obj.ForEach(func(key, value gjson.Result) bool {
+		if _, ok := values[key.String()]; !ok {
+			keys = append(keys, key.String())
+		}
+		values[key.String()] = value
+		return true
+	})
+	return keys, values
+}
+
+func quoteKeys(keys []string) string {
+	data, _ := Marshal[string](keys)
+	return data
+}
+
+// formatPath joins path segments into a gjson path, escaping special characters.
+func formatPath(path []string) string {
+	segments := make([]string, len(path))
+	for i, segment := range path {
+		var b strings.Builder
+		for _, ch := range segment {
+			if ch == '.' || ch == '*' || ch == '?' || ch == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(ch)
+		}
+		segments[i] = b.String()
+	}
+	return strings.Join(segments, ".")
+}
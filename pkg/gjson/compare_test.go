@@ -0,0 +1,100 @@
+package gjson
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEqual(t *testing.T) {
+	Convey("TestEqual", t, func() {
+		Convey("default", func() {
+			So(Equal(`{"a":1,"b":[1,2]}`, `{"b":[1,2],"a":1.0}`), ShouldBeTrue)
+			So(Equal(`{"a":"x"}`, []byte(`{"a":"x"}`)), ShouldBeTrue)
+			So(Equal(`9007199254740993`, `9007199254740992`), ShouldBeFalse)
+			So(Equal(`[1,2]`, `[2,1]`), ShouldBeFalse)
+			So(Equal(`{"a":true}`, `{"a":false}`), ShouldBeFalse)
+			So(Equal(`{"a":null}`, `{}`), ShouldBeFalse)
+			So(Equal(`{"a":`, `{"a":`), ShouldBeFalse)
+		})
+		Convey("key order", func() {
+			So(Equal(`{"a":1,"b":2}`, `{"b":2,"a":1}`, WithIgnoreKeyOrder(false)), ShouldBeFalse)
+			So(Equal(`{"a":1,"b":2}`, `{"a":1,"b":2}`, WithIgnoreKeyOrder(false)), ShouldBeTrue)
+		})
+		Convey("array order", func() {
+			So(Equal(`[1,2,2,{"a":1}]`, `[{"a":1},2,1,2]`, WithIgnoreArrayOrder(true)), ShouldBeTrue)
+			So(Equal(`[1,2,2]`, `[1,1,2]`, WithIgnoreArrayOrder(true)), ShouldBeFalse)
+			So(Equal(`[1,2]`, `[2,1]`, WithIgnoreArrayOrder(true), WithIgnoreArrayOrder(false)), ShouldBeFalse)
+		})
+		Convey("numeric tolerance", func() {
+			So(Equal(`{"pi":3.14159}`, `{"pi":3.1416}`, WithNumericTolerance(0.001)), ShouldBeTrue)
+			So(Equal(`{"pi":3.14159}`, `{"pi":3.15}`, WithNumericTolerance(0.001)), ShouldBeFalse)
+		})
+		Convey("ignored paths", func() {
+			a := `{"id":1,"updated_at":"x","items":[{"id":1,"v":1}]}`
+			b := `{"id":1,"items":[{"id":2,"v":1}],"updated_at":"y"}`
+			So(Equal(a, b, WithIgnoredPaths("updated_at", "items.*.id")), ShouldBeTrue)
+			So(Equal(a, b, WithIgnoredPaths("updated_at")), ShouldBeFalse)
+		})
+	})
+}
+
+func TestCompare(t *testing.T) {
+	Convey("TestCompare", t, func() {
+		Convey("differences", func() {
+			diffs, err := Compare(`{"a":1,"b":2,"list":[1,2,3],"x.y":1}`, `{"a":2,"c":3,"list":[1,3],"x.y":2}`)
+			So(err, ShouldBeNil)
+			So(diffs, ShouldResemble, []Difference{
+				{Path: "a", Kind: DiffChanged, Left: "1", Right: "2"},
+				{Path: "b", Kind: DiffRemoved, Left: "2"},
+				{Path: "list.1", Kind: DiffChanged, Left: "2", Right: "3"},
+				{Path: "list.2", Kind: DiffRemoved, Left: "3"},
+				{Path: `x\.y`, Kind: DiffChanged, Left: "1", Right: "2"},
+				{Path: "c", Kind: DiffAdded, Right: "3"},
+			})
+		})
+		Convey("strings", func() {
+			diffs, err := Compare(`1`, `"1"`)
+			So(err, ShouldBeNil)
+			So(len(diffs), ShouldEqual, 1)
+			So(diffs[0].String(), ShouldEqual, "`(root)` changed: 1 != \"1\"")
+		})
+		Convey("key order", func() {
+			diffs, err := Compare(`{"o":{"a":1,"b":2}}`, `{"o":{"b":2,"a":1}}`, WithIgnoreKeyOrder(false))
+			So(err, ShouldBeNil)
+			So(len(diffs), ShouldEqual, 1)
+			So(diffs[0].String(), ShouldEqual, "`o` key order: [\"a\",\"b\"] != [\"b\",\"a\"]")
+		})
+		Convey("unordered arrays", func() {
+			diffs, err := Compare(`[1,2,3]`, `[3,4,1]`, WithIgnoreArrayOrder(true))
+			So(err, ShouldBeNil)
+			So(diffs, ShouldResemble, []Difference{
+				{Path: "1", Kind: DiffRemoved, Left: "2"},
+				{Path: "1", Kind: DiffAdded, Right: "4"},
+			})
+		})
+		Convey("invalid json", func() {
+			_, err := Compare(`{}`, `{`)
+			So(err, ShouldEqual, ErrInvalidJSON)
+		})
+	})
+}
+
+func TestShouldMatchJSON(t *testing.T) {
+	Convey("TestShouldMatchJSON", t, func() {
+		type User struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		}
+		So(User{ID: 1, Name: "John"}, ShouldMatchJSON, `{"name":"John","id":1.0}`)
+		So(json.RawMessage(`[1,2]`), ShouldMatchJSON, []byte(`[2,1]`), WithIgnoreArrayOrder(true))
+
+		So(ShouldMatchJSON(`{"a":1}`), ShouldContainSubstring, "requires an expected JSON value")
+		So(ShouldMatchJSON(`{"a":1}`, `{"a":1}`, 1), ShouldContainSubstring, "Expected CompareOption")
+		So(ShouldMatchJSON(`{"a":1}`, `{"a`), ShouldContainSubstring, "invalid json")
+		So(ShouldMatchJSON(func() {}, `{}`), ShouldContainSubstring, "Expected actual value to be JSON")
+		So(ShouldMatchJSON(`{"a":1,"b":1}`, `{"a":2}`), ShouldEqual,
+			"Expected JSON documents to be equal, but found 2 difference(s):\n  `a` changed: 1 != 2\n  `b` removed: 1")
+	})
+}
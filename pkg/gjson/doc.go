@@ -28,6 +28,16 @@
 //	// Extract with default value
 //	age := gjson.UnmarshalFromPathWithDefault[int](data, "user.age", 0)
 //
+// # Queries
+//
+// Filter, sort, project, group and aggregate arrays without intermediate structs:
+//
+//	q := gjson.NewQuery("orders").
+//	    Where(gjson.Eq("status", "paid"), gjson.Gt("amount", 100.0)).
+//	    GroupBy("customer.name", gjson.Count("orders"), gjson.Sum("total", "amount"))
+//
+//	report, err := gjson.UnmarshalQuery[[]CustomerTotal](data, q)
+//
 // # Encoding/Decoding Options
 //
 // Customize JSON handling with options:
@@ -38,6 +48,68 @@
 //	// Encode with custom formatting
 //	json, err := gjson.Marshal[string](v, gjson.WithIndent("", "  "))
 //
+// # Polymorphic Values
+//
+// Decode payloads carrying a "type" discriminator into registered concrete types:
+//
+//	gjson.RegisterType[Event, UserCreated]("user_created")
+//	gjson.RegisterType[Event, UserDeleted]("user_deleted")
+//
+//	event, err := gjson.UnmarshalPolymorphic[Event](data)
+//	data, err := gjson.MarshalPolymorphic[Event, string](event)
+//
+//	// Nested fields and slices
+//	type Batch struct {
+//	    Events []gjson.Poly[Event] `json:"events"`
+//	}
+//
+// # Comparison
+//
+// Compare JSON documents semantically instead of byte-for-byte:
+//
+//	// Key order and number formatting are ignored
+//	ok := gjson.Equal(`{"a":1,"b":2}`, `{"b":2,"a":1.0}`)
+//
+//	// List differences with their paths
+//	diffs, err := gjson.Compare(actual, expected, gjson.WithIgnoredPaths("updated_at"))
+//
+//	// Use as a goconvey assertion
+//	So(body, gjson.ShouldMatchJSON, expected, gjson.WithIgnoreArrayOrder(true))
+//
+// # Untrusted Input
+//
+// Reject oversized or deeply nested documents before decoding:
+//
+//	req, err := gjson.Unmarshal[Request](body,
+//	    gjson.WithMaxBytes(1<<20),
+//	    gjson.WithMaxDepth(32),
+//	    gjson.WithMaxArrayLen(1000),
+//	    gjson.WithMaxStringLen(4096),
+//	)
+//	if errors.Is(err, gjson.ErrLimitExceeded) {
+//	    // err reports the offending path, e.g. "`items` array length limit exceeded: max 1000"
+//	}
+//
+// # Redaction
+//
+// Mask sensitive fields before logging:
+//
+//	type Login struct {
+//	    User     string `json:"user"`
+//	    Password string `json:"password" sensitive:"true"`
+//	}
+//
+//	// Mask tagged fields and fields matched by path patterns
+//	str := gjson.DumpsRedacted(login, gjson.RedactRule{Pattern: "headers.Authorization"})
+//
+//	// Keep the last 4 characters of card numbers
+//	json, err := gjson.Marshal[string](v, gjson.WithRedaction(
+//	    gjson.RedactRule{Pattern: "*.card", Mask: gjson.MaskLast(4)},
+//	))
+//
+//	// Redact raw JSON
+//	out, err := gjson.RedactJSON(body, gjson.RedactRule{Pattern: "password"})
+//
 // For path expression syntax, refer to https://github.com/tidwall/gjson#path-syntax
 package gjson
 
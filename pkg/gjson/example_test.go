@@ -2,6 +2,7 @@ package gjson_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/geebos/gocraft/pkg/gjson"
@@ -227,3 +228,144 @@ func ExampleWithIndent() {
 	//     "b": 2
 	// }
 }
+
+func ExampleWithRedaction() {
+	type Login struct {
+		User     string `json:"user"`
+		Password string `json:"password" sensitive:"true"`
+		Card     string `json:"card"`
+	}
+
+	login := Login{User: "john", Password: "secret", Card: "4111111111111111"}
+	jsonStr, _ := gjson.Marshal[string](login, gjson.WithRedaction(
+		gjson.RedactRule{Pattern: "card", Mask: gjson.MaskLast(4)},
+	))
+	fmt.Print(jsonStr)
+
+	// Output:
+	// {"user":"john","password":"******","card":"************1111"}
+}
+
+func ExampleDumpsRedacted() {
+	req := map[string]any{
+		"headers": map[string]string{"Authorization": "Bearer token"},
+		"path":    "/login",
+	}
+	fmt.Println(gjson.DumpsRedacted(req, gjson.RedactRule{Pattern: "headers.Authorization"}))
+
+	// Output:
+	// {"headers":{"Authorization":"******"},"path":"/login"}
+}
+
+func ExampleRedactJSON() {
+	data := `{"user":{"name":"John","password":"secret"}}`
+	out, _ := gjson.RedactJSON(data, gjson.RedactRule{Pattern: "*.password"})
+	fmt.Println(out)
+
+	// Output:
+	// {"user":{"name":"John","password":"******"}}
+}
+
+func ExampleWithMaxDepth() {
+	data := `{"a":{"b":{"c":1}}}`
+
+	_, err := gjson.Unmarshal[map[string]any](data, gjson.WithMaxDepth(2))
+	fmt.Println(errors.Is(err, gjson.ErrLimitExceeded))
+	fmt.Println(err)
+
+	// Output:
+	// true
+	// `a.b` depth limit exceeded: max 2
+}
+
+func ExampleUnmarshalQuery() {
+	data := `{"orders":[
+		{"id":1,"customer":"alice","amount":10},
+		{"id":2,"customer":"bob","amount":5},
+		{"id":3,"customer":"alice","amount":20}
+	]}`
+
+	type Total struct {
+		Customer string `json:"key"`
+		Orders   int    `json:"orders"`
+		Amount   int    `json:"amount"`
+	}
+
+	q := gjson.NewQuery("orders").
+		Where(gjson.Gte("amount", 10)).
+		GroupBy("customer", gjson.Count("orders"), gjson.Sum("amount", "amount"))
+	totals, err := gjson.UnmarshalQuery[[]Total](data, q)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(totals)
+
+	// Output:
+	// [{alice 2 30}]
+}
+
+func ExampleEqual() {
+	fmt.Println(gjson.Equal(`{"a":1,"b":[1,2]}`, `{"b":[1,2],"a":1.0}`))
+	fmt.Println(gjson.Equal(`[1,2,3]`, `[3,1,2]`))
+	fmt.Println(gjson.Equal(`[1,2,3]`, `[3,1,2]`, gjson.WithIgnoreArrayOrder(true)))
+
+	// Output:
+	// true
+	// false
+	// true
+}
+
+func ExampleCompare() {
+	diffs, err := gjson.Compare(`{"a":1,"b":2}`, `{"a":2,"c":3}`)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	for _, d := range diffs {
+		fmt.Println(d)
+	}
+
+	// Output:
+	// `a` changed: 1 != 2
+	// `b` removed: 2
+	// `c` added: 3
+}
+
+type Shape interface {
+	Area() float64
+}
+
+type Circle struct {
+	R float64 `json:"r"`
+}
+
+func (c Circle) Area() float64 { return 3.14 * c.R * c.R }
+
+type Square struct {
+	Side float64 `json:"side"`
+}
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+func init() {
+	// Register concrete types once, typically in the package defining them.
+	gjson.RegisterType[Shape, Circle]("circle")
+	gjson.RegisterType[Shape, Square]("square")
+}
+
+func ExampleUnmarshalPolymorphic() {
+	shape, err := gjson.UnmarshalPolymorphic[Shape](`{"type":"square","side":2}`)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Printf("%T %v\n", shape, shape.Area())
+
+	data, _ := gjson.MarshalPolymorphic[Shape, string](Circle{R: 1})
+	fmt.Println(data)
+
+	// Output:
+	// gjson_test.Square 4
+	// {"type":"circle","r":1}
+}
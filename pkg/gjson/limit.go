@@ -0,0 +1,250 @@
+package gjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+// ErrLimitExceeded is returned when decoded input exceeds a limit configured
+// with [WithMaxBytes], [WithMaxDepth], [WithMaxArrayLen] or [WithMaxStringLen].
+//
+// The concrete error is a [*LimitError], use [errors.Is] to detect it and
+// [errors.As] to inspect the offending path.
+var ErrLimitExceeded = fmt.Errorf("limit exceeded")
+
+// LimitError describes which decoding limit was exceeded and where.
+type LimitError struct {
+	// Limit is the name of the exceeded limit:
+	// "bytes", "depth", "array length" or "string length".
+	Limit string
+	// Max is the configured maximum.
+	Max int
+	// Path is the gjson path of the offending value, empty for the document root.
+	Path string
+}
+
+// Error implements the error interface.
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("`%s` %s %s: max %d", e.Path, e.Limit, ErrLimitExceeded, e.Max)
+}
+
+// Unwrap returns [ErrLimitExceeded].
+func (e *LimitError) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+// WithMaxBytes limits the size of the JSON input in bytes.
+//
+// The size is checked before any parsing happens. When reading from an
+// [io.Reader] with [Decode], at most n+1 bytes are read.
+//
+// Example:
+//
+//	_, err := Unmarshal[Payload](body, WithMaxBytes(1<<20))
+//	if errors.Is(err, ErrLimitExceeded) {
+//	    // reject request
+//	}
+func WithMaxBytes(n int) DecodeOption {
+	return func(opt _option) _option {
+		opt.MaxBytes = gvalue.Ptr(n)
+		return opt
+	}
+}
+
+// WithMaxDepth limits the nesting depth of objects and arrays.
+//
+// The root object or array has depth 1.
+//
+// Example:
+//
+//	_, err := Unmarshal[any](`[[[1]]]`, WithMaxDepth(2))
+//	// err: `0.0` depth limit exceeded: max 2
+func WithMaxDepth(n int) DecodeOption {
+	return func(opt _option) _option {
+		opt.MaxDepth = gvalue.Ptr(n)
+		return opt
+	}
+}
+
+// WithMaxArrayLen limits the number of elements of every array in the input.
+//
+// Example:
+//
+//	_, err := Unmarshal[any](`{"ids":[1,2,3]}`, WithMaxArrayLen(2))
+//	// err: `ids` array length limit exceeded: max 2
+func WithMaxArrayLen(n int) DecodeOption {
+	return func(opt _option) _option {
+		opt.MaxArrayLen = gvalue.Ptr(n)
+		return opt
+	}
+}
+
+// WithMaxStringLen limits the length in characters of every string in the
+// input, object keys included.
+//
+// Example:
+//
+//	_, err := Unmarshal[any](`{"name":"John"}`, WithMaxStringLen(3))
+//	// err: `name` string length limit exceeded: max 3
+func WithMaxStringLen(n int) DecodeOption {
+	return func(opt _option) _option {
+		opt.MaxStringLen = gvalue.Ptr(n)
+		return opt
+	}
+}
+
+// Decode reads JSON from r and stores the result in the value pointed to by v.
+//
+// Decode is the non-generic counterpart of [Unmarshal] for streaming input
+// such as HTTP request bodies. If [WithMaxBytes] is set, Decode stops reading
+// as soon as the limit is exceeded.
+//
+// Example:
+//
+//	var req CreateUserRequest
+//	err := Decode(r.Body, &req, WithMaxBytes(1<<20), WithMaxDepth(32))
+func Decode(r io.Reader, v any, opts ...DecodeOption) error {
+	var opt _option
+	for _, fn := range opts {
+		opt = fn(opt)
+	}
+	if opt.MaxBytes != nil {
+		r = io.LimitReader(r, int64(*opt.MaxBytes)+1)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return opt.Decode(data, v)
+}
+
+// withoutLimits clears the limits of a decode that is part of an already
+// checked document.
+func withoutLimits(opt _option) _option {
+	opt.MaxBytes = nil
+	opt.MaxDepth = nil
+	opt.MaxArrayLen = nil
+	opt.MaxStringLen = nil
+	return opt
+}
+
+func (opt _option) hasLimits() bool {
+	return opt.MaxBytes != nil || opt.MaxDepth != nil || opt.MaxArrayLen != nil || opt.MaxStringLen != nil
+}
+
+// checkLimits validates data against the configured limits without decoding it
+// into Go values.
+func (opt _option) checkLimits(data []byte) error {
+	if opt.MaxBytes != nil && len(data) > *opt.MaxBytes {
+		return &LimitError{Limit: "bytes", Max: *opt.MaxBytes}
+	}
+	if opt.MaxDepth == nil && opt.MaxArrayLen == nil && opt.MaxStringLen == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var stack []*limitFrame
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// Syntax errors are reported by the decoder itself.
+			return nil
+		}
+
+		var top *limitFrame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+
+		// Object keys
+		if top != nil && !top.array && top.wantKey {
+			if key, ok := token.(string); ok {
+				top.key = key
+				top.wantKey = false
+				if err := opt.checkString(key, limitPath(stack)); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		// Closing delimiters
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return nil
+			}
+			stack[len(stack)-1].done()
+			continue
+		}
+
+		// Values
+		if top != nil && top.array {
+			if opt.MaxArrayLen != nil && top.count >= *opt.MaxArrayLen {
+				return &LimitError{Limit: "array length", Max: *opt.MaxArrayLen, Path: limitPath(stack[:len(stack)-1])}
+			}
+			top.key = strconv.Itoa(top.count)
+		}
+		path := limitPath(stack)
+		if delim, ok := token.(json.Delim); ok {
+			if opt.MaxDepth != nil && len(stack)+1 > *opt.MaxDepth {
+				return &LimitError{Limit: "depth", Max: *opt.MaxDepth, Path: path}
+			}
+			stack = append(stack, &limitFrame{array: delim == '[', wantKey: delim == '{'})
+			continue
+		}
+		if s, ok := token.(string); ok {
+			if err := opt.checkString(s, path); err != nil {
+				return err
+			}
+		}
+		if top == nil {
+			return nil
+		}
+		top.done()
+	}
+}
+
+func (opt _option) checkString(s string, path string) error {
+	if opt.MaxStringLen != nil && utf8.RuneCountInString(s) > *opt.MaxStringLen {
+		return &LimitError{Limit: "string length", Max: *opt.MaxStringLen, Path: path}
+	}
+	return nil
+}
+
+// limitFrame tracks an open object or array while checking limits.
+type limitFrame struct {
+	array   bool
+	wantKey bool
+	count   int
+	// key is the current member key or element index.
+	key string
+}
+
+func (f *limitFrame) done() {
+	if f.array {
+		f.count++
+	} else {
+		f.wantKey = true
+	}
+}
+
+// limitPath joins the current keys of the open frames into a gjson path.
+func limitPath(stack []*limitFrame) string {
+	segments := make([]string, 0, len(stack))
+	for _, frame := range stack {
+		segments = append(segments, frame.key)
+	}
+	return strings.Join(segments, ".")
+}
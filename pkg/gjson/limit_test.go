@@ -0,0 +1,84 @@
+package gjson
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDecodeLimits(t *testing.T) {
+	Convey("TestDecodeLimits", t, func() {
+		assertLimit := func(err error, limit, path string) {
+			So(errors.Is(err, ErrLimitExceeded), ShouldBeTrue)
+			var limitErr *LimitError
+			So(errors.As(err, &limitErr), ShouldBeTrue)
+			So(limitErr.Limit, ShouldEqual, limit)
+			So(limitErr.Path, ShouldEqual, path)
+		}
+
+		Convey("max bytes", func() {
+			_, err := Unmarshal[any](`{"a":1}`, WithMaxBytes(6))
+			assertLimit(err, "bytes", "")
+
+			res, err := Unmarshal[map[string]int](`{"a":1}`, WithMaxBytes(7))
+			So(err, ShouldBeNil)
+			So(res, ShouldResemble, map[string]int{"a": 1})
+		})
+
+		Convey("max depth", func() {
+			_, err := Unmarshal[any](`{"a":[{"b":{}}]}`, WithMaxDepth(3))
+			assertLimit(err, "depth", "a.0.b")
+
+			_, err = Unmarshal[any](`{"a":[{"b":1}],"c":{}}`, WithMaxDepth(3))
+			So(err, ShouldBeNil)
+		})
+
+		Convey("max array length", func() {
+			_, err := Unmarshal[any](`{"a":[1,2],"b":{"c":[[1],[1,2,3]]}}`, WithMaxArrayLen(2))
+			assertLimit(err, "array length", "b.c.1")
+
+			_, err = Unmarshal[any](`[1,2,3]`, WithMaxArrayLen(2))
+			assertLimit(err, "array length", "")
+		})
+
+		Convey("max string length", func() {
+			_, err := Unmarshal[any](`{"ls":["ok","long"]}`, WithMaxStringLen(3))
+			assertLimit(err, "string length", "ls.1")
+
+			_, err = Unmarshal[any](`{"long_key":1}`, WithMaxStringLen(3))
+			assertLimit(err, "string length", "long_key")
+
+			_, err = Unmarshal[any](`"日本語"`, WithMaxStringLen(3))
+			So(err, ShouldBeNil)
+		})
+
+		Convey("error message", func() {
+			_, err := Unmarshal[any](`[[[1]]]`, WithMaxDepth(2))
+			So(err.Error(), ShouldEqual, "`0.0` depth limit exceeded: max 2")
+		})
+
+		Convey("syntax error is reported by decoder", func() {
+			_, err := Unmarshal[any](`{"a":`, WithMaxDepth(2))
+			So(err, ShouldNotBeNil)
+			So(errors.Is(err, ErrLimitExceeded), ShouldBeFalse)
+		})
+	})
+}
+
+func TestDecode(t *testing.T) {
+	Convey("TestDecode", t, func() {
+		Convey("success", func() {
+			var res map[string]int
+			err := Decode(strings.NewReader(`{"a":1}`), &res, WithMaxBytes(16))
+			So(err, ShouldBeNil)
+			So(res, ShouldResemble, map[string]int{"a": 1})
+		})
+		Convey("max bytes", func() {
+			var res any
+			err := Decode(strings.NewReader(`{"a":"`+strings.Repeat("x", 100)+`"}`), &res, WithMaxBytes(16))
+			So(errors.Is(err, ErrLimitExceeded), ShouldBeTrue)
+		})
+	})
+}
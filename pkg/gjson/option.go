@@ -11,10 +11,20 @@ type _option struct {
 	// decode options
 	UseNumber            *bool
 	DisableUnknownFields *bool
+	MaxBytes             *int
+	MaxDepth             *int
+	MaxArrayLen          *int
+	MaxStringLen         *int
 	// encode options
 	EscapeHtml   *bool
 	IndentPrefix *string
 	Indent       *string
+	Redaction    *redactor
+	// compare options
+	IgnoreKeyOrder   *bool
+	IgnoreArrayOrder *bool
+	NumericTolerance *float64
+	IgnoredPaths     []string
 }
 
 // EncodeOption is a function that configures JSON encoding behavior.
@@ -120,7 +130,14 @@ func WithIndent(prefix, indent string) EncodeOption {
 // The method applies any configured decoding options:
 //   - DisableUnknownFields: returns error for JSON keys not matching struct fields
 //   - UseNumber: preserves number precision with json.Number type
+//   - MaxBytes, MaxDepth, MaxArrayLen, MaxStringLen: reject oversized input
+//     with [ErrLimitExceeded] before decoding
 func (opt _option) Decode(data []byte, ins any) error {
+	if opt.hasLimits() {
+		if err := opt.checkLimits(data); err != nil {
+			return err
+		}
+	}
 	buf := bytes.NewReader(data)
 	decoder := json.NewDecoder(buf)
 	if opt.DisableUnknownFields != nil && *opt.DisableUnknownFields {
@@ -137,7 +154,11 @@ func (opt _option) Decode(data []byte, ins any) error {
 // The method applies any configured encoding options:
 //   - EscapeHtml: controls HTML-sensitive character escaping
 //   - Indent: configures output indentation format
+//   - Redaction: masks sensitive fields, see [WithRedaction]
 func (opt _option) Encode(v any) ([]byte, error) {
+	if opt.Redaction != nil {
+		return opt.encodeRedacted(v)
+	}
 	buf := bytes.NewBuffer(nil)
 	encoder := json.NewEncoder(buf)
 	if opt.EscapeHtml != nil {
@@ -0,0 +1,322 @@
+package gjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+// ErrUnknownType is returned when a discriminator value or a concrete type
+// is not registered for a polymorphic interface.
+var ErrUnknownType = fmt.Errorf("unknown type")
+
+// DefaultDiscriminator is the JSON field that holds the type name of
+// polymorphic values unless changed with [SetDiscriminator].
+const DefaultDiscriminator = "type"
+
+// RegisterType registers T as the concrete type of interface I for the
+// discriminator value name.
+//
+// T may be a struct type or a pointer type. If only *T implements I, decoded
+// values are returned as pointers. RegisterType panics if neither T nor *T
+// implements I, or if name or T is already registered for I. Register types
+// during package initialization.
+//
+// Example:
+//
+//	type Shape interface{ Area() float64 }
+//	type Circle struct{ R float64 `json:"r"` }
+//	type Rect struct{ W, H float64 }
+//
+//	func init() {
+//	    gjson.RegisterType[Shape, Circle]("circle")
+//	    gjson.RegisterType[Shape, *Rect]("rect")
+//	}
+func RegisterType[I any, T any](name string) {
+	iface := reflect.TypeOf((*I)(nil)).Elem()
+	if iface.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("gjson: RegisterType requires an interface type, got %s", iface))
+	}
+
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	entry := polyType{typ: typ}
+	switch {
+	case typ.Kind() == reflect.Ptr && typ.Implements(iface):
+		entry = polyType{typ: typ.Elem(), ptr: true}
+	case typ.Implements(iface):
+	case reflect.PtrTo(typ).Implements(iface):
+		entry.ptr = true
+	default:
+		panic(fmt.Sprintf("gjson: %s does not implement %s", typ, iface))
+	}
+
+	reg := polyRegistryOf(iface, true)
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	if _, ok := reg.types[name]; ok {
+		panic(fmt.Sprintf("gjson: type name %q already registered for %s", name, iface))
+	}
+	if _, ok := reg.names[entry.typ]; ok {
+		panic(fmt.Sprintf("gjson: %s already registered for %s", entry.typ, iface))
+	}
+	reg.types[name] = entry
+	reg.names[entry.typ] = name
+}
+
+// SetDiscriminator sets the JSON field that holds the type name for
+// interface I. The default is [DefaultDiscriminator].
+//
+// Example:
+//
+//	gjson.SetDiscriminator[Shape]("kind")
+func SetDiscriminator[I any](field string) {
+	reg := polyRegistryOf(reflect.TypeOf((*I)(nil)).Elem(), true)
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.field = field
+}
+
+// UnmarshalPolymorphic parses JSON-encoded data into the concrete type
+// registered for its discriminator value and returns it as interface I.
+//
+// JSON null decodes to the zero value of I. Nested polymorphic fields and
+// slices are supported through [Poly]. Returns [ErrPathNotFound] if the
+// discriminator field is missing and [ErrUnknownType] if its value is not
+// registered with [RegisterType].
+//
+// When [WithDisableUnknownFields] is used, the concrete type must declare
+// the discriminator field.
+//
+// Example:
+//
+//	shape, err := UnmarshalPolymorphic[Shape](`{"type":"circle","r":1}`)
+//	circle := shape.(Circle)
+func UnmarshalPolymorphic[I any, D ~[]byte | ~string](data D, opts ...DecodeOption) (I, error) {
+	result := gvalue.Zero[I]()
+	raw := bytes.TrimSpace([]byte(data))
+	if bytes.Equal(raw, []byte("null")) {
+		return result, nil
+	}
+
+	iface := reflect.TypeOf((*I)(nil)).Elem()
+	reg := polyRegistryOf(iface, false)
+	if reg == nil {
+		return result, fmt.Errorf("`%s` %w", iface, ErrUnknownType)
+	}
+	reg.mu.RLock()
+	field := reg.field
+	reg.mu.RUnlock()
+
+	tag := gjson.GetBytes(raw, escapePathKey(field))
+	if !tag.Exists() {
+		return result, fmt.Errorf("`%s` %w", field, ErrPathNotFound)
+	}
+	reg.mu.RLock()
+	entry, ok := reg.types[tag.String()]
+	reg.mu.RUnlock()
+	if !ok {
+		return result, fmt.Errorf("`%s` %w", tag.String(), ErrUnknownType)
+	}
+
+	ptr := reflect.New(entry.typ)
+	var err error
+	if len(opts) > 0 {
+		err = unmarshalWithOptions(raw, ptr.Interface(), opts)
+	} else {
+		err = json.Unmarshal(raw, ptr.Interface())
+	}
+	if err != nil {
+		return result, err
+	}
+	if entry.ptr {
+		return ptr.Interface().(I), nil
+	}
+	return ptr.Elem().Interface().(I), nil
+}
+
+// UnmarshalPolymorphicSlice parses a JSON array whose elements are decoded
+// as described in [UnmarshalPolymorphic].
+//
+// Limits such as [WithMaxBytes] and [WithMaxDepth] apply to the whole array.
+//
+// Example:
+//
+//	shapes, err := UnmarshalPolymorphicSlice[Shape](`[{"type":"circle","r":1},{"type":"rect","W":1,"H":2}]`)
+func UnmarshalPolymorphicSlice[I any, D ~[]byte | ~string](data D, opts ...DecodeOption) ([]I, error) {
+	var opt _option
+	for _, fn := range opts {
+		opt = fn(opt)
+	}
+	if opt.hasLimits() {
+		if err := opt.checkLimits([]byte(data)); err != nil {
+			return nil, err
+		}
+		opts = append(opts[:len(opts):len(opts)], withoutLimits)
+	}
+
+	elems, err := Unmarshal[[]json.RawMessage](data)
+	if err != nil || elems == nil {
+		return nil, err
+	}
+	result := make([]I, len(elems))
+	for i, elem := range elems {
+		if result[i], err = UnmarshalPolymorphic[I](elem, opts...); err != nil {
+			return nil, fmt.Errorf("`%d` %w", i, err)
+		}
+	}
+	return result, nil
+}
+
+// MarshalPolymorphic returns the JSON encoding of v with the discriminator
+// field of interface I injected as the first field.
+//
+// If the encoding of v already contains the discriminator field, it is kept
+// as is. Returns [ErrUnknownType] if the concrete type of v is not registered.
+// HTML characters are escaped as in [Marshal] unless disabled with
+// [WithEscapeHtml].
+//
+// Example:
+//
+//	data, err := MarshalPolymorphic[Shape, string](Circle{R: 1})
+//	// {"type":"circle","r":1}
+func MarshalPolymorphic[I any, R ~[]byte | ~string](v I, opts ...EncodeOption) (R, error) {
+	data, err := marshalPolymorphic[I](v)
+	if err != nil {
+		return R(data), err
+	}
+	return Marshal[R](json.RawMessage(data), opts...)
+}
+
+// marshalPolymorphic encodes v with the discriminator injected.
+//
+// HTML characters are left unescaped so that the caller's encoder, which
+// escapes raw JSON but never unescapes it, decides how they are written.
+func marshalPolymorphic[I any](v I) ([]byte, error) {
+	value := reflect.ValueOf(&v).Elem()
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+		return []byte("null"), nil
+	}
+
+	iface := reflect.TypeOf((*I)(nil)).Elem()
+	typ := value.Type()
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	reg := polyRegistryOf(iface, false)
+	if reg == nil {
+		return nil, fmt.Errorf("`%s` %w", typ, ErrUnknownType)
+	}
+	reg.mu.RLock()
+	name, ok := reg.names[typ]
+	field := reg.field
+	reg.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("`%s` %w", typ, ErrUnknownType)
+	}
+
+	data, err := Marshal[[]byte](value.Interface(), WithEscapeHtml(false))
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return nil, fmt.Errorf("gjson: polymorphic value %s must encode to a JSON object", typ)
+	}
+	if gjson.GetBytes(data, escapePathKey(field)).Exists() {
+		return data, nil
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)+len(field)+len(name)+6))
+	buf.WriteByte('{')
+	key, _ := Marshal[[]byte](field)
+	val, _ := Marshal[[]byte](name)
+	buf.Write(key)
+	buf.WriteByte(':')
+	buf.Write(val)
+	if rest := bytes.TrimSpace(data[1:]); len(rest) > 0 && rest[0] != '}' {
+		buf.WriteByte(',')
+	}
+	buf.Write(data[1:])
+	return buf.Bytes(), nil
+}
+
+// Poly holds a value of interface I that is encoded and decoded using the
+// discriminator registered for I, see [RegisterType].
+//
+// Use Poly for polymorphic struct fields, slices and maps:
+//
+//	type Drawing struct {
+//	    Background Poly[Shape]   `json:"background"`
+//	    Shapes     []Poly[Shape] `json:"shapes"`
+//	}
+//
+// encoding/json does not pass decoder settings to UnmarshalJSON, so values
+// inside a Poly are always decoded with the default settings: options such
+// as [WithDisableUnknownFields] and [WithUseNumber] given for the enclosing
+// document do not apply to them. Limits such as [WithMaxDepth] are checked
+// on the whole document and therefore still apply.
+type Poly[I any] struct {
+	Value I
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (p Poly[I]) MarshalJSON() ([]byte, error) {
+	return marshalPolymorphic[I](p.Value)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (p *Poly[I]) UnmarshalJSON(data []byte) error {
+	v, err := UnmarshalPolymorphic[I](data)
+	if err != nil {
+		return err
+	}
+	p.Value = v
+	return nil
+}
+
+type polyType struct {
+	typ reflect.Type
+	ptr bool
+}
+
+type polyRegistry struct {
+	mu    sync.RWMutex
+	field string
+	types map[string]polyType
+	names map[reflect.Type]string
+}
+
+var (
+	polyMu         sync.Mutex
+	polyRegistries = make(map[reflect.Type]*polyRegistry)
+)
+
+// polyRegistryOf returns the registry of interface iface, creating it if create is true.
+func polyRegistryOf(iface reflect.Type, create bool) *polyRegistry {
+	polyMu.Lock()
+	defer polyMu.Unlock()
+	reg, ok := polyRegistries[iface]
+	if !ok && create {
+		reg = &polyRegistry{
+			field: DefaultDiscriminator,
+			types: make(map[string]polyType),
+			names: make(map[reflect.Type]string),
+		}
+		polyRegistries[iface] = reg
+	}
+	return reg
+}
+
+// escapePathKey escapes a single object key for use as a gjson path.
+func escapePathKey(key string) string {
+	return formatPath([]string{key})
+}
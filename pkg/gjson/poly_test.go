@@ -0,0 +1,214 @@
+package gjson
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testShape interface {
+	Area() float64
+}
+
+type testCircle struct {
+	R float64 `json:"r"`
+}
+
+func (c testCircle) Area() float64 { return 3 * c.R * c.R }
+
+type testRect struct {
+	W float64 `json:"w"`
+	H float64 `json:"h"`
+}
+
+func (r *testRect) Area() float64 { return r.W * r.H }
+
+type testGroup struct {
+	Name   string            `json:"name"`
+	Shapes []Poly[testShape] `json:"shapes"`
+}
+
+func (g testGroup) Area() float64 {
+	var area float64
+	for _, s := range g.Shapes {
+		area += s.Value.Area()
+	}
+	return area
+}
+
+type testEvent interface{}
+
+type testCreated struct {
+	Kind string `json:"kind"`
+	ID   int    `json:"id"`
+}
+
+func init() {
+	RegisterType[testShape, testCircle]("circle")
+	RegisterType[testShape, testRect]("rect")
+	RegisterType[testShape, *testGroup]("group")
+
+	SetDiscriminator[testEvent]("kind")
+	RegisterType[testEvent, testCreated]("created")
+}
+
+func TestUnmarshalPolymorphic(t *testing.T) {
+	Convey("TestUnmarshalPolymorphic", t, func() {
+		Convey("value and pointer types", func() {
+			shape, err := UnmarshalPolymorphic[testShape](`{"type":"circle","r":2}`)
+			So(err, ShouldBeNil)
+			So(shape, ShouldResemble, testCircle{R: 2})
+
+			shape, err = UnmarshalPolymorphic[testShape]([]byte(`{"w":2,"h":3,"type":"rect"}`))
+			So(err, ShouldBeNil)
+			So(shape, ShouldResemble, &testRect{W: 2, H: 3})
+		})
+
+		Convey("nested", func() {
+			shape, err := UnmarshalPolymorphic[testShape](`{"type":"group","name":"g","shapes":[{"type":"circle","r":1},{"type":"rect","w":1,"h":2}]}`)
+			So(err, ShouldBeNil)
+			group, ok := shape.(*testGroup)
+			So(ok, ShouldBeTrue)
+			So(group.Name, ShouldEqual, "g")
+			So(group.Shapes[0].Value, ShouldResemble, testCircle{R: 1})
+			So(group.Area(), ShouldEqual, 5)
+		})
+
+		Convey("custom discriminator", func() {
+			event, err := UnmarshalPolymorphic[testEvent](`{"kind":"created","id":7}`, WithDisableUnknownFields())
+			So(err, ShouldBeNil)
+			So(event, ShouldResemble, testCreated{Kind: "created", ID: 7})
+		})
+
+		Convey("null", func() {
+			shape, err := UnmarshalPolymorphic[testShape](`null`)
+			So(err, ShouldBeNil)
+			So(shape, ShouldBeNil)
+		})
+
+		Convey("errors", func() {
+			_, err := UnmarshalPolymorphic[testShape](`{"r":1}`)
+			So(errors.Is(err, ErrPathNotFound), ShouldBeTrue)
+
+			_, err = UnmarshalPolymorphic[testShape](`{"type":"square"}`)
+			So(errors.Is(err, ErrUnknownType), ShouldBeTrue)
+
+			_, err = UnmarshalPolymorphic[error](`{"type":"x"}`)
+			So(errors.Is(err, ErrUnknownType), ShouldBeTrue)
+
+			_, err = UnmarshalPolymorphic[testShape](`{"type":"circle","r":"x"}`)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("slice", func() {
+			shapes, err := UnmarshalPolymorphicSlice[testShape](`[{"type":"circle","r":1},{"type":"rect","w":1,"h":2}]`)
+			So(err, ShouldBeNil)
+			So(shapes, ShouldResemble, []testShape{testCircle{R: 1}, &testRect{W: 1, H: 2}})
+
+			_, err = UnmarshalPolymorphicSlice[testShape](`[{"type":"circle"},{"type":"x"}]`)
+			So(errors.Is(err, ErrUnknownType), ShouldBeTrue)
+			So(err.Error(), ShouldStartWith, "`1` ")
+		})
+
+		Convey("decode options do not reach Poly values", func() {
+			data := `{"name":"g","shapes":[{"type":"circle","r":1,"extra":true}]}`
+			_, err := Unmarshal[testGroup](data, WithDisableUnknownFields())
+			So(err, ShouldBeNil)
+
+			_, err = Unmarshal[testGroup](`{"name":"g","extra":true}`, WithDisableUnknownFields())
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("slice limits apply to the whole array", func() {
+			data := `[{"type":"circle","r":1},{"type":"circle","r":2}]`
+			var limitErr *LimitError
+
+			_, err := UnmarshalPolymorphicSlice[testShape](data, WithMaxDepth(1))
+			So(errors.As(err, &limitErr), ShouldBeTrue)
+			So(limitErr.Limit, ShouldEqual, "depth")
+
+			_, err = UnmarshalPolymorphicSlice[testShape](data, WithMaxBytes(len(data)-1))
+			So(errors.As(err, &limitErr), ShouldBeTrue)
+			So(limitErr.Limit, ShouldEqual, "bytes")
+
+			shapes, err := UnmarshalPolymorphicSlice[testShape](data, WithMaxDepth(2), WithMaxBytes(len(data)))
+			So(err, ShouldBeNil)
+			So(shapes, ShouldResemble, []testShape{testCircle{R: 1}, testCircle{R: 2}})
+		})
+	})
+}
+
+func TestMarshalPolymorphic(t *testing.T) {
+	Convey("TestMarshalPolymorphic", t, func() {
+		Convey("inject discriminator", func() {
+			data, err := MarshalPolymorphic[testShape, string](testCircle{R: 1})
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"type":"circle","r":1}`)
+
+			data, err = MarshalPolymorphic[testShape, string](&testRect{W: 1, H: 2})
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"type":"rect","w":1,"h":2}`)
+		})
+
+		Convey("nested", func() {
+			group := &testGroup{Name: "g", Shapes: []Poly[testShape]{{Value: testCircle{R: 1}}}}
+			data, err := MarshalPolymorphic[testShape, string](group)
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"type":"group","name":"g","shapes":[{"type":"circle","r":1}]}`)
+
+			decoded, err := UnmarshalPolymorphic[testShape](data)
+			So(err, ShouldBeNil)
+			So(decoded, ShouldResemble, group)
+		})
+
+		Convey("existing discriminator", func() {
+			data, err := MarshalPolymorphic[testEvent, string](testCreated{Kind: "created", ID: 1})
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"kind":"created","id":1}`)
+		})
+
+		Convey("with options", func() {
+			data, err := MarshalPolymorphic[testShape, string](testCircle{R: 1}, WithIndent("", " "))
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, "{\n \"type\": \"circle\",\n \"r\": 1\n}\n")
+		})
+
+		Convey("escape html like Marshal", func() {
+			group := &testGroup{Name: "<b>"}
+			data, err := MarshalPolymorphic[testShape, string](group)
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"type":"group","name":"\u003cb\u003e","shapes":null}`)
+
+			wrapped, err := Marshal[string](Poly[testShape]{Value: group})
+			So(err, ShouldBeNil)
+			So(wrapped, ShouldEqual, data)
+
+			data, err = MarshalPolymorphic[testShape, string](group, WithEscapeHtml(false))
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, "{\"type\":\"group\",\"name\":\"<b>\",\"shapes\":null}\n")
+
+			wrapped, err = Marshal[string](Poly[testShape]{Value: group}, WithEscapeHtml(false))
+			So(err, ShouldBeNil)
+			So(wrapped, ShouldEqual, data)
+		})
+
+		Convey("nil and errors", func() {
+			data, err := MarshalPolymorphic[testShape, string](nil)
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, "null")
+
+			_, err = MarshalPolymorphic[testEvent, string](testCircle{})
+			So(errors.Is(err, ErrUnknownType), ShouldBeTrue)
+		})
+	})
+}
+
+func TestRegisterType(t *testing.T) {
+	Convey("TestRegisterType", t, func() {
+		So(func() { RegisterType[testCircle, testCircle]("x") }, ShouldPanic)
+		So(func() { RegisterType[testShape, string]("x") }, ShouldPanic)
+		So(func() { RegisterType[testShape, testCircle]("circle") }, ShouldPanic)
+		So(func() { RegisterType[testShape, testCircle]("circle2") }, ShouldPanic)
+	})
+}
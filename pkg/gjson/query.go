@@ -0,0 +1,491 @@
+package gjson
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+// ErrNotArray is returned when a query source path does not point to an array.
+var ErrNotArray = fmt.Errorf("not an array")
+
+// Query is a pipeline of operations that reshapes an array inside a JSON document.
+//
+// A Query is created with [NewQuery] and extended with its methods. Every
+// method returns a new Query, so a base query can be shared and extended
+// safely. Use [UnmarshalQuery] to run a query and decode its result.
+//
+// Example:
+//
+//	q := NewQuery("orders").
+//	    Where(Gt("amount", 100.0)).
+//	    OrderBy(Desc("amount")).
+//	    Project(Field("id", "id"), Field("customer", "customer.name"))
+//
+//	type Row struct {
+//	    ID       int    `json:"id"`
+//	    Customer string `json:"customer"`
+//	}
+//	rows, err := UnmarshalQuery[[]Row](data, q)
+type Query struct {
+	path  string
+	steps []queryStep
+}
+
+// queryStep transforms the current elements. single reports whether the
+// result is a single value rather than a list.
+type queryStep func(elems []gjson.Result) (result []gjson.Result, single bool)
+
+// NewQuery creates a query over the array found at path.
+//
+// An empty path selects the document root. Path expressions follow the
+// gjson syntax, see [UnmarshalFromPath].
+func NewQuery(path string) *Query {
+	return &Query{path: path}
+}
+
+func (q *Query) with(step queryStep) *Query {
+	steps := make([]queryStep, 0, len(q.steps)+1)
+	steps = append(steps, q.steps...)
+	return &Query{path: q.path, steps: append(steps, step)}
+}
+
+// Where keeps only the elements that satisfy all given predicates.
+func (q *Query) Where(preds ...Predicate) *Query {
+	pred := And(preds...)
+	return q.with(func(elems []gjson.Result) ([]gjson.Result, bool) {
+		result := make([]gjson.Result, 0, len(elems))
+		for _, elem := range elems {
+			if pred(elem.Raw) {
+				result = append(result, elem)
+			}
+		}
+		return result, false
+	})
+}
+
+// Project replaces every element with a new object built from the given fields.
+//
+// Fields keep the given order. A field whose path does not exist is set to null.
+func (q *Query) Project(fields ...Projection) *Query {
+	return q.with(func(elems []gjson.Result) ([]gjson.Result, bool) {
+		result := make([]gjson.Result, len(elems))
+		for i, elem := range elems {
+			obj := newObjectBuilder()
+			for _, field := range fields {
+				obj.add(field.Name, elem.Get(field.Path))
+			}
+			result[i] = obj.result()
+		}
+		return result, false
+	})
+}
+
+// OrderBy sorts the elements by the given keys.
+//
+// The sort is stable: elements with equal keys keep their relative order.
+// Numbers are compared numerically and strings lexicographically.
+func (q *Query) OrderBy(keys ...SortKey) *Query {
+	return q.with(func(elems []gjson.Result) ([]gjson.Result, bool) {
+		result := make([]gjson.Result, len(elems))
+		copy(result, elems)
+		sort.SliceStable(result, func(i, j int) bool {
+			for _, key := range keys {
+				l, r := result[i].Get(key.Path), result[j].Get(key.Path)
+				if key.Desc {
+					l, r = r, l
+				}
+				if l.Less(r, true) {
+					return true
+				}
+				if r.Less(l, true) {
+					return false
+				}
+			}
+			return false
+		})
+		return result, false
+	})
+}
+
+// Limit keeps at most the first n elements.
+func (q *Query) Limit(n int) *Query {
+	return q.with(func(elems []gjson.Result) ([]gjson.Result, bool) {
+		if n < len(elems) {
+			elems = elems[:gvalue.IfElse(n < 0, 0, n)]
+		}
+		return elems, false
+	})
+}
+
+// GroupBy groups the elements by the value at path and replaces each group
+// with an object holding the group key under "key" and the given aggregations.
+//
+// Groups are ordered by the first occurrence of their key.
+//
+// Example:
+//
+//	q := NewQuery("orders").GroupBy("customer", Count("orders"), Sum("total", "amount"))
+//	// [{"key":"alice","orders":2,"total":30},{"key":"bob","orders":1,"total":5}]
+func (q *Query) GroupBy(path string, aggs ...Aggregation) *Query {
+	return q.with(func(elems []gjson.Result) ([]gjson.Result, bool) {
+		var keys []gjson.Result
+		groups := make(map[string][]gjson.Result)
+		for _, elem := range elems {
+			key := elem.Get(path)
+			id := groupID(key)
+			if _, ok := groups[id]; !ok {
+				keys = append(keys, key)
+			}
+			groups[id] = append(groups[id], elem)
+		}
+
+		result := make([]gjson.Result, len(keys))
+		for i, key := range keys {
+			obj := newObjectBuilder()
+			obj.add("key", key)
+			obj.aggregate(groups[groupID(key)], aggs)
+			result[i] = obj.result()
+		}
+		return result, false
+	})
+}
+
+// Aggregate collapses all elements into a single object holding the given aggregations.
+//
+// Example:
+//
+//	q := NewQuery("orders").Aggregate(Count("count"), Max("largest", "amount"))
+//	// {"count":3,"largest":20}
+func (q *Query) Aggregate(aggs ...Aggregation) *Query {
+	return q.with(func(elems []gjson.Result) ([]gjson.Result, bool) {
+		obj := newObjectBuilder()
+		obj.aggregate(elems, aggs)
+		return []gjson.Result{obj.result()}, true
+	})
+}
+
+// groupID identifies a group key so that equal strings with different escaping
+// fall into the same group.
+func groupID(key gjson.Result) string {
+	if key.Type == gjson.String {
+		return "s" + key.String()
+	}
+	return key.Raw
+}
+
+func (q *Query) run(data []byte) ([]byte, error) {
+	if !gjson.ValidBytes(data) {
+		return nil, ErrInvalidJSON
+	}
+	source := gjson.ParseBytes(data)
+	if q.path != "" {
+		source = source.Get(q.path)
+	}
+	if !source.Exists() {
+		return nil, fmt.Errorf("`%s` %w", q.path, ErrPathNotFound)
+	}
+	if !source.IsArray() {
+		return nil, fmt.Errorf("`%s` %w", q.path, ErrNotArray)
+	}
+
+	elems := source.Array()
+	single := false
+	for _, step := range q.steps {
+		elems, single = step(elems)
+	}
+	if single {
+		return []byte(elems[0].Raw), nil
+	}
+
+	buf := bytes.NewBuffer(nil)
+	buf.WriteByte('[')
+	for i, elem := range elems {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(elem.Raw)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalQuery runs the query against JSON data and unmarshals the result to type T.
+//
+// The result is an array unless the last step of the query is [Query.Aggregate],
+// in which case it is a single object.
+//
+// Returns [ErrPathNotFound] if the query path does not exist and [ErrNotArray]
+// if it does not point to an array.
+//
+// Example:
+//
+//	data := `{"orders":[{"id":1,"amount":10},{"id":2,"amount":20}]}`
+//	stats, err := UnmarshalQuery[map[string]int](data, NewQuery("orders").Aggregate(Count("count"), Sum("total", "amount")))
+//	// stats = map[count:2 total:30]
+func UnmarshalQuery[T any, D ~[]byte | ~string](data D, q *Query) (T, error) {
+	result, err := q.run([]byte(data))
+	if err != nil {
+		return gvalue.Zero[T](), err
+	}
+	return Unmarshal[T](result)
+}
+
+// Predicate reports whether an array element, given as raw JSON, should be kept.
+type Predicate func(elem string) bool
+
+// Match returns a [Predicate] that unmarshals the value at path to type T
+// and reports the result of fn. Elements where the path is missing or cannot
+// be unmarshaled to T do not match, e.g. Gt("amount", 10) does not match
+// an amount of 10.5 because it cannot be unmarshaled to int.
+//
+// Example:
+//
+//	Match("email", func(email string) bool { return strings.HasSuffix(email, "@example.com") })
+func Match[T any](path string, fn func(T) bool) Predicate {
+	return func(elem string) bool {
+		v, err := UnmarshalFromPath[T](elem, path)
+		return err == nil && fn(v)
+	}
+}
+
+// Eq returns a [Predicate] that matches elements whose value at path equals v.
+func Eq[T comparable](path string, v T) Predicate {
+	return Match(path, func(x T) bool { return gvalue.EQ(x, v) })
+}
+
+// Ne returns a [Predicate] that matches elements whose value at path differs from v.
+//
+// Elements without a value at path do not match.
+func Ne[T comparable](path string, v T) Predicate {
+	return Match(path, func(x T) bool { return !gvalue.EQ(x, v) })
+}
+
+// Gt returns a [Predicate] that matches elements whose value at path is greater than v.
+func Gt[T gvalue.Ordered](path string, v T) Predicate {
+	return Match(path, func(x T) bool { return gvalue.GT(x, v) })
+}
+
+// Gte returns a [Predicate] that matches elements whose value at path is greater than or equal to v.
+func Gte[T gvalue.Ordered](path string, v T) Predicate {
+	return Match(path, func(x T) bool { return gvalue.GTE(x, v) })
+}
+
+// Lt returns a [Predicate] that matches elements whose value at path is less than v.
+func Lt[T gvalue.Ordered](path string, v T) Predicate {
+	return Match(path, func(x T) bool { return gvalue.LT(x, v) })
+}
+
+// Lte returns a [Predicate] that matches elements whose value at path is less than or equal to v.
+func Lte[T gvalue.Ordered](path string, v T) Predicate {
+	return Match(path, func(x T) bool { return gvalue.LTE(x, v) })
+}
+
+// Exists returns a [Predicate] that matches elements that have a value at path.
+func Exists(path string) Predicate {
+	return func(elem string) bool {
+		return gjson.Get(elem, path).Exists()
+	}
+}
+
+// And returns a [Predicate] that matches when all preds match.
+func And(preds ...Predicate) Predicate {
+	return func(elem string) bool {
+		for _, pred := range preds {
+			if !pred(elem) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a [Predicate] that matches when at least one of preds matches.
+func Or(preds ...Predicate) Predicate {
+	return func(elem string) bool {
+		for _, pred := range preds {
+			if pred(elem) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns a [Predicate] that matches when pred does not match.
+func Not(pred Predicate) Predicate {
+	return func(elem string) bool {
+		return !pred(elem)
+	}
+}
+
+// Projection describes a field of the objects produced by [Query.Project].
+type Projection struct {
+	// Name is the key of the field in the projected object.
+	Name string
+	// Path is the gjson path of the value within the source element.
+	Path string
+}
+
+// Field returns a [Projection] that copies the value at path into the field name.
+func Field(name, path string) Projection {
+	return Projection{Name: name, Path: path}
+}
+
+// SortKey describes a sort key for [Query.OrderBy].
+type SortKey struct {
+	Path string
+	Desc bool
+}
+
+// Asc returns a [SortKey] that sorts by the value at path in ascending order.
+func Asc(path string) SortKey {
+	return SortKey{Path: path}
+}
+
+// Desc returns a [SortKey] that sorts by the value at path in descending order.
+func Desc(path string) SortKey {
+	return SortKey{Path: path, Desc: true}
+}
+
+// Aggregation computes a value over a group of elements for [Query.GroupBy]
+// and [Query.Aggregate].
+type Aggregation struct {
+	name string
+	fn   func(elems []gjson.Result) string
+}
+
+// Count returns an [Aggregation] stored under name that counts the elements.
+func Count(name string) Aggregation {
+	return Aggregation{name: name, fn: func(elems []gjson.Result) string {
+		return strconv.Itoa(len(elems))
+	}}
+}
+
+// Sum returns an [Aggregation] stored under name that sums the numbers at path.
+//
+// Values that are missing or not numbers are ignored. The result is null
+// when the sum overflows float64, since JSON cannot represent infinity.
+func Sum(name, path string) Aggregation {
+	return Aggregation{name: name, fn: func(elems []gjson.Result) string {
+		var sum float64
+		for _, elem := range elems {
+			if v := elem.Get(path); v.Type == gjson.Number {
+				sum += v.Float()
+			}
+		}
+		return formatFloat(sum)
+	}}
+}
+
+// Avg returns an [Aggregation] stored under name that averages the numbers at path.
+//
+// Values that are missing or not numbers are ignored. The result is null
+// when there are no numbers or the sum overflows float64.
+func Avg(name, path string) Aggregation {
+	return Aggregation{name: name, fn: func(elems []gjson.Result) string {
+		var sum float64
+		var count int
+		for _, elem := range elems {
+			if v := elem.Get(path); v.Type == gjson.Number {
+				sum += v.Float()
+				count++
+			}
+		}
+		if count == 0 {
+			return "null"
+		}
+		return formatFloat(sum / float64(count))
+	}}
+}
+
+// Min returns an [Aggregation] stored under name holding the smallest value at path.
+//
+// Missing values are ignored. The result is null when no element has a value.
+func Min(name, path string) Aggregation {
+	return Aggregation{name: name, fn: func(elems []gjson.Result) string {
+		return extremum(elems, path, func(l, r gjson.Result) bool { return l.Less(r, true) })
+	}}
+}
+
+// Max returns an [Aggregation] stored under name holding the largest value at path.
+//
+// Missing values are ignored. The result is null when no element has a value.
+func Max(name, path string) Aggregation {
+	return Aggregation{name: name, fn: func(elems []gjson.Result) string {
+		return extremum(elems, path, func(l, r gjson.Result) bool { return r.Less(l, true) })
+	}}
+}
+
+func extremum(elems []gjson.Result, path string, better func(l, r gjson.Result) bool) string {
+	var best gjson.Result
+	for _, elem := range elems {
+		v := elem.Get(path)
+		if !v.Exists() || v.Type == gjson.Null {
+			continue
+		}
+		if !best.Exists() || better(v, best) {
+			best = v
+		}
+	}
+	if !best.Exists() {
+		return "null"
+	}
+	return best.Raw
+}
+
+// formatFloat formats f as a JSON number, or null if f is infinite or NaN.
+func formatFloat(f float64) string {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "null"
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// objectBuilder writes a JSON object field by field, keeping insertion order.
+type objectBuilder struct {
+	buf   bytes.Buffer
+	first bool
+}
+
+func newObjectBuilder() *objectBuilder {
+	b := &objectBuilder{first: true}
+	b.buf.WriteByte('{')
+	return b
+}
+
+func (b *objectBuilder) add(name string, value gjson.Result) {
+	raw := value.Raw
+	if !value.Exists() {
+		raw = "null"
+	}
+	b.addRaw(name, raw)
+}
+
+func (b *objectBuilder) addRaw(name, raw string) {
+	if !b.first {
+		b.buf.WriteByte(',')
+	}
+	b.first = false
+	name, _ = Marshal[string](name)
+	b.buf.WriteString(name)
+	b.buf.WriteByte(':')
+	b.buf.WriteString(raw)
+}
+
+func (b *objectBuilder) aggregate(elems []gjson.Result, aggs []Aggregation) {
+	for _, agg := range aggs {
+		b.addRaw(agg.name, agg.fn(elems))
+	}
+}
+
+func (b *objectBuilder) result() gjson.Result {
+	b.buf.WriteByte('}')
+	return gjson.Parse(b.buf.String())
+}
@@ -0,0 +1,140 @@
+package gjson
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnmarshalQuery(t *testing.T) {
+	Convey("TestUnmarshalQuery", t, func() {
+		data := `{"orders":[
+			{"id":1,"customer":{"name":"alice"},"amount":10,"status":"paid"},
+			{"id":2,"customer":{"name":"bob"},"amount":25.5,"status":"open"},
+			{"id":3,"customer":{"name":"alice"},"amount":20,"status":"paid"},
+			{"id":4,"customer":{"name":"carol"},"amount":5}
+		]}`
+
+		type Row struct {
+			ID       int     `json:"id"`
+			Customer string  `json:"customer"`
+			Amount   float64 `json:"amount"`
+		}
+
+		Convey("where, order and project", func() {
+			q := NewQuery("orders").
+				Where(Gt("amount", 8.0), Ne("customer.name", "bob")).
+				OrderBy(Desc("amount")).
+				Project(Field("id", "id"), Field("customer", "customer.name"), Field("amount", "amount"))
+			rows, err := UnmarshalQuery[[]Row](data, q)
+			So(err, ShouldBeNil)
+			So(rows, ShouldResemble, []Row{
+				{ID: 3, Customer: "alice", Amount: 20},
+				{ID: 1, Customer: "alice", Amount: 10},
+			})
+		})
+
+		Convey("projection keeps order and fills missing fields", func() {
+			q := NewQuery("orders").Where(Eq("id", 4)).Project(Field("status", "status"), Field("id", "id"))
+			res, err := q.run([]byte(data))
+			So(err, ShouldBeNil)
+			So(string(res), ShouldEqual, `[{"status":null,"id":4}]`)
+		})
+
+		Convey("predicates", func() {
+			type ID struct {
+				ID int `json:"id"`
+			}
+			q := NewQuery("orders").Where(Or(
+				Eq("status", "open"),
+				Not(Exists("status")),
+			), Match("customer.name", func(name string) bool { return strings.HasPrefix(name, "c") }))
+			ids, err := UnmarshalQuery[[]ID](data, q.Project(Field("id", "id")))
+			So(err, ShouldBeNil)
+			So(ids, ShouldResemble, []ID{{ID: 4}})
+
+			ids, err = UnmarshalQuery[[]ID](data, NewQuery("orders").Where(Lt("amount", 10), Lte("id", 4), Gte("id", 4)))
+			So(err, ShouldBeNil)
+			So(ids, ShouldResemble, []ID{{ID: 4}})
+		})
+
+		Convey("group by", func() {
+			type Group struct {
+				Key    string  `json:"key"`
+				Orders int     `json:"orders"`
+				Total  float64 `json:"total"`
+				Avg    float64 `json:"avg"`
+				First  int     `json:"first"`
+				Last   int     `json:"last"`
+			}
+			q := NewQuery("orders").GroupBy("customer.name",
+				Count("orders"), Sum("total", "amount"), Avg("avg", "amount"), Min("first", "id"), Max("last", "id"))
+			groups, err := UnmarshalQuery[[]Group](data, q)
+			So(err, ShouldBeNil)
+			So(groups, ShouldResemble, []Group{
+				{Key: "alice", Orders: 2, Total: 30, Avg: 15, First: 1, Last: 3},
+				{Key: "bob", Orders: 1, Total: 25.5, Avg: 25.5, First: 2, Last: 2},
+				{Key: "carol", Orders: 1, Total: 5, Avg: 5, First: 4, Last: 4},
+			})
+		})
+
+		Convey("aggregate", func() {
+			type Stats struct {
+				Count int      `json:"count"`
+				Max   float64  `json:"max"`
+				Min   string   `json:"min"`
+				Avg   *float64 `json:"avg"`
+			}
+			stats, err := UnmarshalQuery[Stats](data, NewQuery("orders").Aggregate(
+				Count("count"), Max("max", "amount"), Min("min", "status"), Avg("avg", "missing")))
+			So(err, ShouldBeNil)
+			So(stats, ShouldResemble, Stats{Count: 4, Max: 25.5, Min: "open"})
+		})
+
+		Convey("aggregate overflow", func() {
+			type Stats struct {
+				Sum *float64 `json:"sum"`
+				Avg *float64 `json:"avg"`
+			}
+			q := NewQuery("").Aggregate(Sum("sum", "v"), Avg("avg", "v"))
+			stats, err := UnmarshalQuery[Stats](`[{"v":1e308},{"v":1e308}]`, q)
+			So(err, ShouldBeNil)
+			So(stats, ShouldResemble, Stats{})
+
+			stats, err = UnmarshalQuery[Stats](`[{"v":1e308},{"v":-1e308}]`, q)
+			So(err, ShouldBeNil)
+			So(*stats.Sum, ShouldEqual, 0)
+		})
+
+		Convey("limit", func() {
+			ids, err := UnmarshalQuery[[]int](`[5,4,3,2,1]`, NewQuery("").OrderBy(Asc("@this")).Limit(2))
+			So(err, ShouldBeNil)
+			So(ids, ShouldResemble, []int{1, 2})
+
+			ids, err = UnmarshalQuery[[]int](`[1]`, NewQuery("").Limit(-1))
+			So(err, ShouldBeNil)
+			So(ids, ShouldResemble, []int{})
+		})
+
+		Convey("query is immutable", func() {
+			base := NewQuery("orders").Where(Eq("status", "paid"))
+			_ = base.Limit(1)
+			rows, err := UnmarshalQuery[[]map[string]any](data, base)
+			So(err, ShouldBeNil)
+			So(len(rows), ShouldEqual, 2)
+		})
+
+		Convey("errors", func() {
+			_, err := UnmarshalQuery[[]Row](data, NewQuery("missing"))
+			So(errors.Is(err, ErrPathNotFound), ShouldBeTrue)
+
+			_, err = UnmarshalQuery[[]Row](data, NewQuery("orders.0"))
+			So(errors.Is(err, ErrNotArray), ShouldBeTrue)
+
+			_, err = UnmarshalQuery[[]Row](`{"orders":`, NewQuery("orders"))
+			So(err, ShouldEqual, ErrInvalidJSON)
+		})
+	})
+}
@@ -0,0 +1,483 @@
+package gjson
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// ErrInvalidJSON is returned when raw JSON input cannot be parsed.
+var ErrInvalidJSON = fmt.Errorf("invalid json")
+
+// DefaultMask is the replacement written for values redacted without a custom [Masker].
+const DefaultMask = "******"
+
+// Masker produces the masked replacement for a sensitive value.
+//
+// The value passed to a Masker is the decoded string for JSON strings and
+// the raw JSON text for any other value. The returned string is always
+// encoded as a JSON string.
+type Masker func(value string) string
+
+// RedactRule selects fields to redact by path pattern.
+//
+// Pattern is a dot-separated path where each segment is matched against
+// object keys (case-insensitively) or array indices:
+//   - "password" - a pattern without dots matches the key at any depth
+//   - "headers.Authorization" - matches the exact path from the root
+//   - "*.password" - "*" matches exactly one segment
+//   - "**.token" - "**" matches any number of segments, including none
+//
+// Mask controls how the matched value is replaced. If Mask is nil,
+// the value is replaced with [DefaultMask].
+type RedactRule struct {
+	Pattern string
+	Mask    Masker
+}
+
+// MaskAll returns a [Masker] that replaces the whole value with [DefaultMask].
+func MaskAll() Masker {
+	return func(string) string {
+		return DefaultMask
+	}
+}
+
+// MaskLast returns a [Masker] that keeps the last n characters of the value
+// and replaces the rest with '*'.
+//
+// Values with n or fewer characters are fully masked so that short secrets
+// are never revealed. A negative n is treated as 0.
+//
+// Example:
+//
+//	MaskLast(4)("4111111111111111") // "************1111"
+func MaskLast(n int) Masker {
+	if n < 0 {
+		n = 0
+	}
+	return func(value string) string {
+		runes := []rune(value)
+		if len(runes) <= n {
+			return strings.Repeat("*", len(runes))
+		}
+		return strings.Repeat("*", len(runes)-n) + string(runes[len(runes)-n:])
+	}
+}
+
+// MaskFirst returns a [Masker] that keeps the first n characters of the value
+// and replaces the rest with '*'.
+//
+// Values with n or fewer characters are fully masked so that short secrets
+// are never revealed. A negative n is treated as 0.
+//
+// Example:
+//
+//	MaskFirst(3)("john@example.com") // "joh*************"
+func MaskFirst(n int) Masker {
+	if n < 0 {
+		n = 0
+	}
+	return func(value string) string {
+		runes := []rune(value)
+		if len(runes) <= n {
+			return strings.Repeat("*", len(runes))
+		}
+		return string(runes[:n]) + strings.Repeat("*", len(runes)-n)
+	}
+}
+
+// WithRedaction configures the encoder to mask sensitive fields.
+//
+// Struct fields tagged with `sensitive:"true"` are always masked with
+// [DefaultMask]. Additional fields are selected by the given rules, see
+// [RedactRule] for the pattern syntax. Rules are checked in order and the
+// first matching rule wins. Calling WithRedaction multiple times appends rules.
+//
+// Tags are not looked up inside values that implement [json.Marshaler] or
+// [encoding.TextMarshaler], since their encoding need not follow their
+// fields. Select sensitive parts of such values with a rule.
+//
+// Raw JSON can be redacted by passing it as [json.RawMessage].
+//
+// Example:
+//
+//	type Login struct {
+//	    User     string `json:"user"`
+//	    Password string `json:"password" sensitive:"true"`
+//	    Card     string `json:"card"`
+//	}
+//
+//	data, _ := Marshal[string](login, WithRedaction(
+//	    RedactRule{Pattern: "card", Mask: MaskLast(4)},
+//	))
+//	// {"user":"john","password":"******","card":"************1111"}
+func WithRedaction(rules ...RedactRule) EncodeOption {
+	return func(opt _option) _option {
+		r := &redactor{}
+		if opt.Redaction != nil {
+			r.rules = append(r.rules, opt.Redaction.rules...)
+		}
+		for _, rule := range rules {
+			r.rules = append(r.rules, compileRule(rule))
+		}
+		opt.Redaction = r
+		return opt
+	}
+}
+
+// DumpsRedacted is like [Dumps] but masks sensitive fields as described in
+// [WithRedaction].
+//
+// Example:
+//
+//	log.Println(DumpsRedacted(req, RedactRule{Pattern: "headers.Authorization"}))
+func DumpsRedacted[T any](v T, rules ...RedactRule) string {
+	data, err := Marshal[string](v, WithRedaction(rules...))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(data, "\n")
+}
+
+// RedactJSON masks the fields of raw JSON data matched by the given rules.
+//
+// The data parameter can be either []byte or string (or any type with
+// an underlying type of []byte or string). The output is compact and keeps
+// the key order of the input.
+//
+// Returns [ErrInvalidJSON] if data is not valid JSON.
+//
+// Example:
+//
+//	out, err := RedactJSON(`{"user":{"password":"secret"}}`, RedactRule{Pattern: "*.password"})
+//	// out = `{"user":{"password":"******"}}`
+func RedactJSON[D ~[]byte | ~string](data D, rules ...RedactRule) (D, error) {
+	raw := []byte(data)
+	if !gjson.ValidBytes(raw) {
+		return data, ErrInvalidJSON
+	}
+	var opt _option
+	opt = WithRedaction(rules...)(opt)
+	out, err := opt.Redaction.redact(raw, nil, true)
+	if err != nil {
+		return data, err
+	}
+	return D(out), nil
+}
+
+// encodeRedacted encodes v and masks sensitive fields before indentation is applied.
+func (opt _option) encodeRedacted(v any) ([]byte, error) {
+	plain := opt
+	plain.Redaction = nil
+	plain.IndentPrefix = nil
+	plain.Indent = nil
+	data, err := plain.Encode(v)
+	if err != nil {
+		return data, err
+	}
+
+	escapeHtml := opt.EscapeHtml == nil || *opt.EscapeHtml
+	out, err := opt.Redaction.redact(data, taggedPaths(reflect.ValueOf(v)), escapeHtml)
+	if err != nil {
+		return nil, err
+	}
+	if opt.IndentPrefix != nil {
+		buf := bytes.NewBuffer(nil)
+		if err := json.Indent(buf, out, *opt.IndentPrefix, *opt.Indent); err != nil {
+			return nil, err
+		}
+		out = buf.Bytes()
+	}
+	return append(out, '\n'), nil
+}
+
+type redactRule struct {
+	segments []string
+	mask     Masker
+}
+
+func compileRule(rule RedactRule) redactRule {
+	mask := rule.Mask
+	if mask == nil {
+		mask = MaskAll()
+	}
+	return redactRule{segments: compilePattern(rule.Pattern), mask: mask}
+}
+
+// compilePattern splits a path pattern into segments. A pattern without dots
+// matches the key at any depth.
+func compilePattern(pattern string) []string {
+	segments := strings.Split(pattern, ".")
+	if len(segments) == 1 && segments[0] != "*" && segments[0] != "**" {
+		segments = []string{"**", segments[0]}
+	}
+	return segments
+}
+
+type redactor struct {
+	rules []redactRule
+}
+
+// redact rewrites data with every field matched by a rule or listed in tagged masked.
+func (r *redactor) redact(data []byte, tagged [][]string, escapeHtml bool) ([]byte, error) {
+	w := &redactWriter{
+		redactor:   r,
+		tagged:     tagged,
+		escapeHtml: escapeHtml,
+	}
+	w.write(gjson.ParseBytes(data), nil)
+	return w.buf.Bytes(), w.err
+}
+
+func (r *redactor) match(path []string) (Masker, bool) {
+	for _, rule := range r.rules {
+		if matchSegments(rule.segments, path) {
+			return rule.mask, true
+		}
+	}
+	return nil, false
+}
+
+func matchSegments(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchSegments(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 {
+		return false
+	}
+	if pattern[0] != "*" && !strings.EqualFold(pattern[0], path[0]) {
+		return false
+	}
+	return matchSegments(pattern[1:], path[1:])
+}
+
+type redactWriter struct {
+	redactor   *redactor
+	tagged     [][]string
+	escapeHtml bool
+	buf        bytes.Buffer
+	err        error
+}
+
+func (w *redactWriter) write(res gjson.Result, path []string) {
+	if mask, ok := w.masker(path); ok {
+		value := res.Raw
+		if res.Type == gjson.String {
+			value = res.String()
+		}
+		w.writeString(mask(value))
+		return
+	}
+
+	switch {
+	case res.IsObject():
+		w.buf.WriteByte('{')
+		first := true
+		res.ForEach(func(key, value gjson.Result) bool {
+			if !first {
+				w.buf.WriteByte(',')
+			}
+			first = false
+			w.buf.WriteString(key.Raw)
+			w.buf.WriteByte(':')
+			w.write(value, appendPath(path, key.String()))
+			return w.err == nil
+		})
+		w.buf.WriteByte('}')
+	case res.IsArray():
+		w.buf.WriteByte('[')
+		i := 0
+		res.ForEach(func(_, value gjson.Result) bool {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			w.write(value, appendPath(path, strconv.Itoa(i)))
+			i++
+			return w.err == nil
+		})
+		w.buf.WriteByte(']')
+	default:
+		w.buf.WriteString(res.Raw)
+	}
+}
+
+func (w *redactWriter) masker(path []string) (Masker, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+	for _, tagged := range w.tagged {
+		if equalSegments(tagged, path) {
+			return MaskAll(), true
+		}
+	}
+	return w.redactor.match(path)
+}
+
+func (w *redactWriter) writeString(s string) {
+	buf := bytes.NewBuffer(nil)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(w.escapeHtml)
+	if err := encoder.Encode(s); err != nil {
+		w.err = err
+		return
+	}
+	w.buf.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+func appendPath(path []string, segment string) []string {
+	next := make([]string, len(path)+1)
+	copy(next, path)
+	next[len(path)] = segment
+	return next
+}
+
+func equalSegments(l, r []string) bool {
+	if len(l) != len(r) {
+		return false
+	}
+	for i := range l {
+		if l[i] != r[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// taggedPaths collects the JSON paths of struct fields tagged `sensitive:"true"` in v.
+func taggedPaths(v reflect.Value) [][]string {
+	var paths [][]string
+	collectTaggedPaths(v, nil, &paths, 0)
+	return paths
+}
+
+// maxTaggedDepth bounds the reflection walk so cyclic values cannot recurse forever.
+const maxTaggedDepth = 64
+
+var (
+	marshalerType     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// collectTaggedPaths walks v the way encoding/json does. Values with a custom
+// encoding are not descended into, since their output need not mirror their fields.
+func collectTaggedPaths(v reflect.Value, path []string, paths *[][]string, depth int) {
+	if depth > maxTaggedDepth {
+		return
+	}
+	for {
+		if isCustomMarshaler(v) {
+			return
+		}
+		if v.Kind() != reflect.Ptr && v.Kind() != reflect.Interface {
+			break
+		}
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		collectStructPaths(v, path, paths, depth)
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			collectTaggedPaths(v.Index(i), appendPath(path, strconv.Itoa(i)), paths, depth+1)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			key, ok := mapKeyName(iter.Key())
+			if !ok {
+				continue
+			}
+			collectTaggedPaths(iter.Value(), appendPath(path, key), paths, depth+1)
+		}
+	}
+}
+
+func collectStructPaths(v reflect.Value, path []string, paths *[][]string, depth int) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				collectTaggedPaths(v.Field(i), path, paths, depth+1)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldPath := appendPath(path, name)
+		if sensitive, _ := strconv.ParseBool(field.Tag.Get("sensitive")); sensitive {
+			*paths = append(*paths, fieldPath)
+			continue
+		}
+		collectTaggedPaths(v.Field(i), fieldPath, paths, depth+1)
+	}
+}
+
+// isCustomMarshaler reports whether encoding/json encodes v with MarshalJSON or MarshalText.
+func isCustomMarshaler(v reflect.Value) bool {
+	t := v.Type()
+	if t.Kind() != reflect.Interface && (t.Implements(marshalerType) || t.Implements(textMarshalerType)) {
+		return true
+	}
+	if t.Kind() != reflect.Ptr && v.CanAddr() {
+		pt := reflect.PtrTo(t)
+		return pt.Implements(marshalerType) || pt.Implements(textMarshalerType)
+	}
+	return false
+}
+
+// mapKeyName returns the object key encoding/json writes for the map key k.
+func mapKeyName(k reflect.Value) (string, bool) {
+	if k.Kind() == reflect.String {
+		return k.String(), true
+	}
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		if k.Kind() == reflect.Ptr && k.IsNil() {
+			return "", true
+		}
+		text, err := tm.MarshalText()
+		return string(text), err == nil
+	}
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(k.Uint(), 10), true
+	}
+	return "", false
+}
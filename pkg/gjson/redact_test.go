@@ -0,0 +1,134 @@
+package gjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testToken struct {
+	ID     string `json:"id"`
+	Secret string `json:"secret" sensitive:"true"`
+}
+
+// MarshalJSON encodes the secret under "value" and the id under "secret".
+func (t testToken) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"secret": t.ID, "value": t.Secret})
+}
+
+type testKey int
+
+func (k testKey) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("k%d", int(k))), nil
+}
+
+func TestWithRedaction(t *testing.T) {
+	Convey("TestWithRedaction", t, func() {
+		type Credentials struct {
+			User     string `json:"user"`
+			Password string `json:"password" sensitive:"true"`
+		}
+		type Request struct {
+			Credentials
+			Headers map[string]string `json:"headers"`
+			Card    string            `json:"card"`
+			Tokens  []Credentials     `json:"tokens"`
+		}
+		req := Request{
+			Credentials: Credentials{User: "john", Password: "secret"},
+			Headers:     map[string]string{"Authorization": "Bearer abc"},
+			Card:        "4111111111111111",
+			Tokens:      []Credentials{{User: "a", Password: "b"}},
+		}
+
+		Convey("tagged fields", func() {
+			data, err := Marshal[string](req, WithRedaction())
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"user":"john","password":"******","headers":{"Authorization":"Bearer abc"},"card":"4111111111111111","tokens":[{"user":"a","password":"******"}]}`+"\n")
+		})
+
+		Convey("rules", func() {
+			data, err := Marshal[string](req, WithRedaction(
+				RedactRule{Pattern: "headers.authorization"},
+				RedactRule{Pattern: "card", Mask: MaskLast(4)},
+			))
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"user":"john","password":"******","headers":{"Authorization":"******"},"card":"************1111","tokens":[{"user":"a","password":"******"}]}`+"\n")
+		})
+
+		Convey("with indent", func() {
+			data, err := Marshal[string](Credentials{User: "john", Password: "secret"}, WithRedaction(), WithIndent("", "  "))
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, "{\n  \"user\": \"john\",\n  \"password\": \"******\"\n}\n")
+		})
+
+		Convey("custom marshalers", func() {
+			type Session struct {
+				Token  testToken               `json:"token"`
+				Tokens []*testToken            `json:"tokens"`
+				Users  map[testKey]Credentials `json:"users"`
+			}
+			session := Session{
+				Token:  testToken{ID: "t1", Secret: "s1"},
+				Tokens: []*testToken{{ID: "t2", Secret: "s2"}},
+				Users:  map[testKey]Credentials{1: {User: "john", Password: "secret"}},
+			}
+			data, err := Marshal[string](session, WithRedaction())
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"token":{"secret":"t1","value":"s1"},"tokens":[{"secret":"t2","value":"s2"}],"users":{"k1":{"user":"john","password":"******"}}}`+"\n")
+
+			data, err = Marshal[string](session, WithRedaction(RedactRule{Pattern: "**.value"}))
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"token":{"secret":"t1","value":"******"},"tokens":[{"secret":"t2","value":"******"}],"users":{"k1":{"user":"john","password":"******"}}}`+"\n")
+		})
+
+		Convey("raw message", func() {
+			raw := json.RawMessage(`{"user":{"password":123},"list":[{"password":"x"}]}`)
+			data, err := Marshal[string](raw, WithRedaction(RedactRule{Pattern: "*.password"}))
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, `{"user":{"password":"******"},"list":[{"password":"x"}]}`+"\n")
+		})
+	})
+}
+
+func TestDumpsRedacted(t *testing.T) {
+	Convey("TestDumpsRedacted", t, func() {
+		v := map[string]any{"token": "abcdef", "name": "john"}
+		So(DumpsRedacted(v, RedactRule{Pattern: "token", Mask: MaskFirst(2)}), ShouldEqual, `{"name":"john","token":"ab****"}`)
+		So(DumpsRedacted(func() {}), ShouldEqual, "")
+	})
+}
+
+func TestRedactJSON(t *testing.T) {
+	Convey("TestRedactJSON", t, func() {
+		Convey("deep wildcard keeps order", func() {
+			data := `{"b":{"c":{"token":"x"}},"a":[1,{"token":true}],"token":null}`
+			out, err := RedactJSON(data, RedactRule{Pattern: "**.token"})
+			So(err, ShouldBeNil)
+			So(out, ShouldEqual, `{"b":{"c":{"token":"******"}},"a":[1,{"token":"******"}],"token":"******"}`)
+		})
+		Convey("array index", func() {
+			out, err := RedactJSON([]byte(`{"keys":["a","b"]}`), RedactRule{Pattern: "keys.1"})
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, `{"keys":["a","******"]}`)
+		})
+		Convey("invalid json", func() {
+			_, err := RedactJSON(`{"a":`)
+			So(err, ShouldEqual, ErrInvalidJSON)
+		})
+	})
+}
+
+func TestMaskers(t *testing.T) {
+	Convey("TestMaskers", t, func() {
+		So(MaskAll()("anything"), ShouldEqual, DefaultMask)
+		So(MaskLast(4)("1234"), ShouldEqual, "****")
+		So(MaskLast(2)("日本語"), ShouldEqual, "*本語")
+		So(MaskFirst(1)("abc"), ShouldEqual, "a**")
+		So(MaskLast(-1)("abc"), ShouldEqual, "***")
+		So(MaskFirst(-2)("abc"), ShouldEqual, "***")
+		So(MaskLast(-1)(""), ShouldEqual, "")
+	})
+}
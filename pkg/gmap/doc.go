@@ -0,0 +1,48 @@
+// Package gmap provides generic utility functions for map operations.
+//
+// The package complements gslice with common operations on maps, such as
+// extracting keys and values, transforming, filtering, merging and inverting
+// maps. Entries are represented as [gslice.Pair] values so they can be
+// processed with gslice functions.
+//
+// # Operations
+//
+// The package provides the following operations:
+//
+//   - [Keys], [Values], [Entries]: extract the contents of a map in unspecified order
+//   - [SortedKeys], [SortedValues], [SortedEntries]: extract the contents in sorted order
+//   - [FromEntries]: builds a map from key-value pairs
+//   - [MapKeys], [MapValues]: transform keys or values
+//   - [FilterMap]: keeps the entries that satisfy a predicate
+//   - [Merge], [MergeWith]: combine maps, resolving conflicts
+//   - [Invert]: swaps keys and values
+//   - [Pick], [Omit]: keep or drop the given keys
+//   - [GetOr]: returns a value or a default
+//   - [RangeSorted]: iterates over a map in sorted key order
+//
+// # Deterministic Iteration
+//
+// Go randomizes map iteration order. Use the sorted variants with a
+// comparison function such as
+// [github.com/geebos/gocraft/pkg/gvalue.Less] when the order matters, for
+// example for stable output or tests:
+//
+//	m := map[string]int{"b": 2, "a": 1}
+//	gmap.SortedKeys(m, gvalue.Less[string]) // []string{"a", "b"}
+//	gmap.RangeSorted(m, gvalue.Less[string], func(k string, v int) bool {
+//	    fmt.Println(k, v)
+//	    return true
+//	})
+//
+// # Containers
+//
+// [SyncMap] is a typed wrapper of sync.Map. [OrderedMap] remembers the
+// insertion order of its keys and keeps it in its JSON encoding; decode it
+// with [UnmarshalOrderedMap] to pass gjson decode options. [LRU] is a
+// concurrency-safe least-recently-used cache with optional TTL, eviction
+// callback and hit/miss statistics:
+//
+//	cache := gmap.NewLRU[string, User](10000, 5*time.Minute, nil)
+//	cache.Set("42", user)
+//	user, ok := cache.Get("42")
+package gmap
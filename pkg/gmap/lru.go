@@ -0,0 +1,267 @@
+package gmap
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// EvictReason tells why an entry left an [LRU].
+type EvictReason int
+
+const (
+	// EvictCapacity means the entry was the least recently used one when
+	// the cache was full.
+	EvictCapacity EvictReason = iota
+	// EvictExpired means the entry outlived its TTL.
+	EvictExpired
+	// EvictRemoved means the entry was removed with Delete or Purge.
+	EvictRemoved
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case EvictCapacity:
+		return "capacity"
+	case EvictExpired:
+		return "expired"
+	case EvictRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// LRUStats holds the counters of an [LRU].
+type LRUStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// LRU is a least-recently-used cache with an optional TTL that is safe for
+// concurrent use.
+//
+// When the cache holds capacity entries, adding a new key evicts the least
+// recently used entry. Entries older than the TTL are treated as missing and
+// are removed lazily on access or with [LRU.RemoveExpired].
+//
+// Example:
+//
+//	cache := NewLRU[string, int](1000, time.Minute, func(key string, value int, reason EvictReason) {
+//	    log.Printf("evicted %s: %s", key, reason)
+//	})
+//	cache.Set("a", 1)
+//	v, ok := cache.Get("a")
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	onEvict  func(key K, value V, reason EvictReason)
+	now      func() time.Time
+	items    map[K]*list.Element
+	order    *list.List
+	stats    LRUStats
+}
+
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+type eviction[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictReason
+}
+
+// NewLRU creates a cache holding at most capacity entries.
+//
+// A capacity of 0 or less means no limit. A ttl of 0 or less means entries
+// never expire. onEvict, if not nil, is called for each entry that leaves the
+// cache, outside of the cache lock so it may use the cache.
+func NewLRU[K comparable, V any](capacity int, ttl time.Duration, onEvict func(key K, value V, reason EvictReason)) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: capacity,
+		ttl:      ttl,
+		onEvict:  onEvict,
+		now:      time.Now,
+		items:    make(map[K]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value for key and marks it as recently used.
+//
+// Get counts a hit or a miss in [LRU.Stats]. An expired entry is removed and
+// counted as a miss.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	var value V
+	c.mu.Lock()
+	entry, evicted := c.lookup(key)
+	found := entry != nil
+	if found {
+		// Copy the value under the lock, Set updates entries in place.
+		value = entry.value
+		c.stats.Hits++
+		c.order.MoveToFront(c.items[key])
+	} else {
+		c.stats.Misses++
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return value, found
+}
+
+// Peek returns the value for key without marking it as recently used or
+// updating the stats.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*lruEntry[K, V])
+		if !c.expired(entry) {
+			return entry.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Set adds or replaces the value for key, marks it as recently used and
+// resets its TTL. If the cache is full, the least recently used entry is
+// evicted.
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	var evicted []eviction[K, V]
+	expiresAt := time.Time{}
+	if c.ttl > 0 {
+		expiresAt = c.now().Add(c.ttl)
+	}
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*lruEntry[K, V])
+		entry.value, entry.expiresAt = value, expiresAt
+		c.order.MoveToFront(elem)
+	} else {
+		c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt})
+		for c.capacity > 0 && c.order.Len() > c.capacity {
+			evicted = append(evicted, c.remove(c.order.Back(), EvictCapacity))
+		}
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Delete removes key and reports whether it was present.
+func (c *LRU[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	elem, ok := c.items[key]
+	var evicted []eviction[K, V]
+	if ok {
+		evicted = append(evicted, c.remove(elem, EvictRemoved))
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return ok
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// that have not been removed yet.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Keys returns the keys of unexpired entries from the most to the least
+// recently used.
+func (c *LRU[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make([]K, 0, c.order.Len())
+	for elem := c.order.Front(); elem != nil; elem = elem.Next() {
+		if entry := elem.Value.(*lruEntry[K, V]); !c.expired(entry) {
+			result = append(result, entry.key)
+		}
+	}
+	return result
+}
+
+// RemoveExpired removes all expired entries and returns how many were removed.
+func (c *LRU[K, V]) RemoveExpired() int {
+	c.mu.Lock()
+	var evicted []eviction[K, V]
+	for elem := c.order.Back(); elem != nil; {
+		prev := elem.Prev()
+		if c.expired(elem.Value.(*lruEntry[K, V])) {
+			evicted = append(evicted, c.remove(elem, EvictExpired))
+		}
+		elem = prev
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return len(evicted)
+}
+
+// Purge removes all entries.
+func (c *LRU[K, V]) Purge() {
+	c.mu.Lock()
+	var evicted []eviction[K, V]
+	for elem := c.order.Back(); elem != nil; {
+		prev := elem.Prev()
+		evicted = append(evicted, c.remove(elem, EvictRemoved))
+		elem = prev
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Stats returns the hit, miss and eviction counters. Evictions count entries
+// removed because of capacity or expiry.
+func (c *LRU[K, V]) Stats() LRUStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// lookup returns the unexpired entry for key, removing it if it expired.
+func (c *LRU[K, V]) lookup(key K) (*lruEntry[K, V], []eviction[K, V]) {
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, nil
+	}
+	entry := elem.Value.(*lruEntry[K, V])
+	if c.expired(entry) {
+		return nil, []eviction[K, V]{c.remove(elem, EvictExpired)}
+	}
+	return entry, nil
+}
+
+func (c *LRU[K, V]) expired(entry *lruEntry[K, V]) bool {
+	return !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt)
+}
+
+func (c *LRU[K, V]) remove(elem *list.Element, reason EvictReason) eviction[K, V] {
+	entry := c.order.Remove(elem).(*lruEntry[K, V])
+	delete(c.items, entry.key)
+	if reason != EvictRemoved {
+		c.stats.Evictions++
+	}
+	return eviction[K, V]{key: entry.key, value: entry.value, reason: reason}
+}
+
+// notify calls the eviction callback. It must be called without holding the lock.
+func (c *LRU[K, V]) notify(evicted []eviction[K, V]) {
+	if c.onEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		c.onEvict(e.key, e.value, e.reason)
+	}
+}
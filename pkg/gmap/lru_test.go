@@ -0,0 +1,170 @@
+package gmap
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type evictRecord struct {
+	key    string
+	value  int
+	reason EvictReason
+}
+
+func newTestLRU(capacity int, ttl time.Duration) (*LRU[string, int], *[]evictRecord, *time.Time) {
+	var evicted []evictRecord
+	now := time.Unix(1000, 0)
+	cache := NewLRU(capacity, ttl, func(key string, value int, reason EvictReason) {
+		evicted = append(evicted, evictRecord{key, value, reason})
+	})
+	cache.now = func() time.Time { return now }
+	return cache, &evicted, &now
+}
+
+func TestLRUCapacity(t *testing.T) {
+	cache, evicted, _ := newTestLRU(2, 0)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Get("a")
+	cache.Set("c", 3)
+
+	if !reflect.DeepEqual(cache.Keys(), []string{"c", "a"}) {
+		t.Errorf("Keys() = %v, want [c a]", cache.Keys())
+	}
+	if !reflect.DeepEqual(*evicted, []evictRecord{{"b", 2, EvictCapacity}}) {
+		t.Errorf("evicted %v, want b by capacity", *evicted)
+	}
+
+	cache.Set("a", 10)
+	if v, ok := cache.Peek("a"); v != 10 || !ok {
+		t.Errorf("Peek() = %v, %v, want 10, true", v, ok)
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", cache.Len())
+	}
+
+	stats := cache.Stats()
+	if stats != (LRUStats{Hits: 1, Misses: 0, Evictions: 1}) {
+		t.Errorf("Stats() = %+v, want 1 hit and 1 eviction", stats)
+	}
+}
+
+func TestLRUTTL(t *testing.T) {
+	cache, evicted, now := newTestLRU(0, time.Minute)
+	cache.Set("a", 1)
+	*now = now.Add(30 * time.Second)
+	cache.Set("b", 2)
+
+	if v, ok := cache.Get("a"); v != 1 || !ok {
+		t.Errorf("Get() before expiry = %v, %v, want 1, true", v, ok)
+	}
+
+	*now = now.Add(40 * time.Second)
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("Get() after expiry returned ok = true")
+	}
+	if !reflect.DeepEqual(cache.Keys(), []string{"b"}) {
+		t.Errorf("Keys() = %v, want [b]", cache.Keys())
+	}
+
+	*now = now.Add(time.Minute)
+	if _, ok := cache.Peek("b"); ok {
+		t.Errorf("Peek() after expiry returned ok = true")
+	}
+	if n := cache.RemoveExpired(); n != 1 || cache.Len() != 0 {
+		t.Errorf("RemoveExpired() = %v, Len() = %v, want 1, 0", n, cache.Len())
+	}
+
+	expected := []evictRecord{{"a", 1, EvictExpired}, {"b", 2, EvictExpired}}
+	if !reflect.DeepEqual(*evicted, expected) {
+		t.Errorf("evicted %v, want %v", *evicted, expected)
+	}
+	if stats := cache.Stats(); stats != (LRUStats{Hits: 1, Misses: 1, Evictions: 2}) {
+		t.Errorf("Stats() = %+v, want 1 hit, 1 miss and 2 evictions", stats)
+	}
+}
+
+func TestLRURemove(t *testing.T) {
+	cache, evicted, _ := newTestLRU(0, 0)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+
+	if !cache.Delete("b") || cache.Delete("b") {
+		t.Errorf("Delete() returned unexpected result")
+	}
+	cache.Purge()
+	if cache.Len() != 0 {
+		t.Errorf("Len() after Purge() = %v, want 0", cache.Len())
+	}
+
+	expected := []evictRecord{{"b", 2, EvictRemoved}, {"a", 1, EvictRemoved}, {"c", 3, EvictRemoved}}
+	if !reflect.DeepEqual(*evicted, expected) {
+		t.Errorf("evicted %v, want %v", *evicted, expected)
+	}
+	if stats := cache.Stats(); stats.Evictions != 0 {
+		t.Errorf("Stats().Evictions = %v, want 0", stats.Evictions)
+	}
+}
+
+func TestLRUCallbackMayUseCache(t *testing.T) {
+	var cache *LRU[string, int]
+	cache = NewLRU(1, 0, func(key string, value int, _ EvictReason) {
+		cache.Peek(key)
+	})
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	if !reflect.DeepEqual(cache.Keys(), []string{"b"}) {
+		t.Errorf("Keys() = %v, want [b]", cache.Keys())
+	}
+}
+
+func TestLRUConcurrent(t *testing.T) {
+	cache := NewLRU[int, int](50, time.Minute, nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				cache.Set(i*1000+j, j)
+				cache.Get(j)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if cache.Len() != 50 {
+		t.Errorf("Len() = %v, want 50", cache.Len())
+	}
+	if stats := cache.Stats(); stats.Hits+stats.Misses != 1600 || stats.Evictions != 1550 {
+		t.Errorf("Stats() = %+v, want 1600 lookups and 1550 evictions", stats)
+	}
+}
+
+func TestLRUConcurrentSameKey(t *testing.T) {
+	cache := NewLRU[string, int](10, time.Minute, nil)
+	cache.Set("a", 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				cache.Set("a", i*1000+j)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				if _, ok := cache.Get("a"); !ok {
+					t.Errorf("Get() missed a present key")
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
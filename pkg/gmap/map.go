@@ -0,0 +1,297 @@
+package gmap
+
+import "github.com/geebos/gocraft/pkg/gslice"
+
+// Keys returns the keys of the map in unspecified order.
+//
+// If the map is nil, Keys returns nil.
+//
+// Example:
+//
+//	keys := Keys(map[string]int{"a": 1, "b": 2})
+//	// keys is []string{"a", "b"} in some order
+func Keys[K comparable, V any](m map[K]V) []K {
+	if m == nil {
+		return nil
+	}
+	result := make([]K, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
+
+// SortedKeys returns the keys of the map sorted according to less.
+//
+// Example:
+//
+//	keys := SortedKeys(map[string]int{"b": 2, "a": 1}, gvalue.Less[string])
+//	// keys is []string{"a", "b"}
+func SortedKeys[K comparable, V any](m map[K]V, less func(K, K) bool) []K {
+	return gslice.StealSort(Keys(m), less)
+}
+
+// Values returns the values of the map in unspecified order.
+//
+// If the map is nil, Values returns nil.
+//
+// Example:
+//
+//	values := Values(map[string]int{"a": 1, "b": 2})
+//	// values is []int{1, 2} in some order
+func Values[K comparable, V any](m map[K]V) []V {
+	if m == nil {
+		return nil
+	}
+	result := make([]V, 0, len(m))
+	for _, v := range m {
+		result = append(result, v)
+	}
+	return result
+}
+
+// SortedValues returns the values of the map sorted according to less.
+//
+// Example:
+//
+//	values := SortedValues(map[string]int{"a": 2, "b": 1}, gvalue.Less[int])
+//	// values is []int{1, 2}
+func SortedValues[K comparable, V any](m map[K]V, less func(V, V) bool) []V {
+	return gslice.StealSort(Values(m), less)
+}
+
+// Entries returns the key-value pairs of the map in unspecified order.
+//
+// If the map is nil, Entries returns nil.
+//
+// Example:
+//
+//	entries := Entries(map[string]int{"a": 1})
+//	// entries is []gslice.Pair[string, int]{{"a", 1}}
+func Entries[K comparable, V any](m map[K]V) []gslice.Pair[K, V] {
+	if m == nil {
+		return nil
+	}
+	result := make([]gslice.Pair[K, V], 0, len(m))
+	for k, v := range m {
+		result = append(result, gslice.Pair[K, V]{First: k, Second: v})
+	}
+	return result
+}
+
+// SortedEntries returns the key-value pairs of the map sorted by key
+// according to less.
+//
+// Example:
+//
+//	entries := SortedEntries(map[string]int{"b": 2, "a": 1}, gvalue.Less[string])
+//	// entries is []gslice.Pair[string, int]{{"a", 1}, {"b", 2}}
+func SortedEntries[K comparable, V any](m map[K]V, less func(K, K) bool) []gslice.Pair[K, V] {
+	return gslice.StealSort(Entries(m), func(a, b gslice.Pair[K, V]) bool {
+		return less(a.First, b.First)
+	})
+}
+
+// FromEntries builds a map from key-value pairs. If a key occurs several
+// times, the last pair wins.
+//
+// Example:
+//
+//	m := FromEntries([]gslice.Pair[string, int]{{"a", 1}, {"b", 2}})
+//	// m is map[string]int{"a": 1, "b": 2}
+func FromEntries[K comparable, V any](entries []gslice.Pair[K, V]) map[K]V {
+	if entries == nil {
+		return nil
+	}
+	result := make(map[K]V, len(entries))
+	for _, e := range entries {
+		result[e.First] = e.Second
+	}
+	return result
+}
+
+// MapValues returns a new map with the same keys and the values transformed by fn.
+//
+// If the map is nil, MapValues returns nil.
+//
+// Example:
+//
+//	doubled := MapValues(map[string]int{"a": 1}, func(v int) int { return v * 2 })
+//	// doubled is map[string]int{"a": 2}
+func MapValues[K comparable, V, R any](m map[K]V, fn func(V) R) map[K]R {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]R, len(m))
+	for k, v := range m {
+		result[k] = fn(v)
+	}
+	return result
+}
+
+// MapKeys returns a new map with the keys transformed by fn and the same values.
+//
+// If fn maps several keys to the same key, which value is kept is
+// unspecified. If the map is nil, MapKeys returns nil.
+//
+// Example:
+//
+//	upper := MapKeys(map[string]int{"a": 1}, strings.ToUpper)
+//	// upper is map[string]int{"A": 1}
+func MapKeys[K comparable, V any, R comparable](m map[K]V, fn func(K) R) map[R]V {
+	if m == nil {
+		return nil
+	}
+	result := make(map[R]V, len(m))
+	for k, v := range m {
+		result[fn(k)] = v
+	}
+	return result
+}
+
+// FilterMap returns a new map with the entries that satisfy the predicate.
+//
+// If the map is nil, FilterMap returns nil.
+//
+// Example:
+//
+//	adults := FilterMap(ages, func(name string, age int) bool { return age >= 18 })
+func FilterMap[K comparable, V any](m map[K]V, fn func(K, V) bool) map[K]V {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]V)
+	for k, v := range m {
+		if fn(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Merge returns a new map with the entries of all maps. If a key exists in
+// several maps, the value of the last map wins.
+//
+// The input maps are not modified.
+//
+// Example:
+//
+//	m := Merge(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3})
+//	// m is map[string]int{"a": 1, "b": 3}
+func Merge[K comparable, V any](maps ...map[K]V) map[K]V {
+	return MergeWith(func(_ K, _, v V) V { return v }, maps...)
+}
+
+// MergeWith returns a new map with the entries of all maps, calling resolve
+// to combine the current and the new value when a key exists in several maps.
+//
+// Maps are merged from first to last. The input maps are not modified.
+//
+// Example:
+//
+//	totals := MergeWith(func(_ string, a, b int) int { return a + b }, jan, feb)
+func MergeWith[K comparable, V any](resolve func(key K, current, next V) V, maps ...map[K]V) map[K]V {
+	size := 0
+	for _, m := range maps {
+		size += len(m)
+	}
+	result := make(map[K]V, size)
+	for _, m := range maps {
+		for k, v := range m {
+			if current, ok := result[k]; ok {
+				v = resolve(k, current, v)
+			}
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Invert returns a new map with keys and values swapped.
+//
+// If several keys have the same value, which key is kept is unspecified.
+// If the map is nil, Invert returns nil.
+//
+// Example:
+//
+//	codes := Invert(map[string]int{"ok": 200, "not found": 404})
+//	// codes is map[int]string{200: "ok", 404: "not found"}
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	if m == nil {
+		return nil
+	}
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
+// Pick returns a new map with only the given keys. Keys not in the map are ignored.
+//
+// If the map is nil, Pick returns nil.
+//
+// Example:
+//
+//	m := Pick(map[string]int{"a": 1, "b": 2, "c": 3}, "a", "c")
+//	// m is map[string]int{"a": 1, "c": 3}
+func Pick[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Omit returns a new map without the given keys.
+//
+// If the map is nil, Omit returns nil.
+//
+// Example:
+//
+//	m := Omit(map[string]int{"a": 1, "b": 2, "c": 3}, "b")
+//	// m is map[string]int{"a": 1, "c": 3}
+func Omit[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	omitted := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		omitted[k] = struct{}{}
+	}
+	return FilterMap(m, func(k K, _ V) bool {
+		_, ok := omitted[k]
+		return !ok
+	})
+}
+
+// GetOr returns the value of key, or def if the key is not in the map.
+//
+// Example:
+//
+//	port := GetOr(config, "port", 8080)
+func GetOr[K comparable, V any](m map[K]V, key K, def V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return def
+}
+
+// RangeSorted calls fn for each entry of the map in the key order defined by
+// less. If fn returns false, RangeSorted stops the iteration.
+//
+// Example:
+//
+//	RangeSorted(m, gvalue.Less[string], func(k string, v int) bool {
+//	    fmt.Println(k, v)
+//	    return true
+//	})
+func RangeSorted[K comparable, V any](m map[K]V, less func(K, K) bool, fn func(K, V) bool) {
+	for _, k := range SortedKeys(m, less) {
+		if !fn(k, m[k]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package gmap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/geebos/gocraft/pkg/gslice"
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+func TestKeysValues(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+
+	tests := []struct {
+		name     string
+		result   any
+		expected any
+	}{
+		{name: "keys", result: gslice.Sort(Keys(m), gvalue.Less[string]), expected: []string{"a", "b", "c"}},
+		{name: "sorted keys", result: SortedKeys(m, gvalue.Less[string]), expected: []string{"a", "b", "c"}},
+		{name: "sorted keys desc", result: SortedKeys(m, gvalue.GT[string]), expected: []string{"c", "b", "a"}},
+		{name: "values", result: gslice.Sort(Values(m), gvalue.Less[int]), expected: []int{1, 2, 3}},
+		{name: "sorted values", result: SortedValues(m, gvalue.Less[int]), expected: []int{1, 2, 3}},
+		{name: "nil keys", result: Keys[string, int](nil), expected: []string(nil)},
+		{name: "nil values", result: Values[string, int](nil), expected: []int(nil)},
+		{name: "empty keys", result: Keys(map[string]int{}), expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.result, tt.expected) {
+				t.Errorf("%s = %v, want %v", tt.name, tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEntries(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1}
+	entries := SortedEntries(m, gvalue.Less[string])
+	expected := []gslice.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Errorf("SortedEntries() = %v, want %v", entries, expected)
+	}
+	if result := FromEntries(Entries(m)); !reflect.DeepEqual(result, m) {
+		t.Errorf("FromEntries(Entries()) = %v, want %v", result, m)
+	}
+	if result := FromEntries(append(expected, gslice.Pair[string, int]{First: "a", Second: 9})); result["a"] != 9 {
+		t.Errorf("FromEntries() = %v, want last pair to win", result)
+	}
+	if result := FromEntries[string, int](nil); result != nil {
+		t.Errorf("FromEntries() on nil = %v, want nil", result)
+	}
+
+	filtered := FromEntries(gslice.Filter(Entries(m), func(p gslice.Pair[string, int]) bool { return p.Second > 1 }))
+	if !reflect.DeepEqual(filtered, map[string]int{"b": 2}) {
+		t.Errorf("FromEntries(gslice.Filter()) = %v, want map[b:2]", filtered)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	tests := []struct {
+		name     string
+		result   any
+		expected any
+	}{
+		{
+			name:     "map values",
+			result:   MapValues(m, func(v int) int { return v * 10 }),
+			expected: map[string]int{"a": 10, "b": 20},
+		},
+		{
+			name:     "map keys",
+			result:   MapKeys(m, strings.ToUpper),
+			expected: map[string]int{"A": 1, "B": 2},
+		},
+		{
+			name:     "filter map",
+			result:   FilterMap(m, func(k string, v int) bool { return k == "a" || v > 5 }),
+			expected: map[string]int{"a": 1},
+		},
+		{
+			name:     "invert",
+			result:   Invert(m),
+			expected: map[int]string{1: "a", 2: "b"},
+		},
+		{
+			name:     "pick",
+			result:   Pick(m, "a", "z"),
+			expected: map[string]int{"a": 1},
+		},
+		{
+			name:     "omit",
+			result:   Omit(m, "a", "z"),
+			expected: map[string]int{"b": 2},
+		},
+		{
+			name:     "nil map values",
+			result:   MapValues(map[string]int(nil), func(v int) int { return v }),
+			expected: map[string]int(nil),
+		},
+		{
+			name:     "nil pick",
+			result:   Pick(map[string]int(nil), "a"),
+			expected: map[string]int(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.result, tt.expected) {
+				t.Errorf("%s = %v, want %v", tt.name, tt.result, tt.expected)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual(m, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("transformations modified the input map: %v", m)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 3, "z": 4}
+
+	if result := Merge(a, b); !reflect.DeepEqual(result, map[string]int{"x": 1, "y": 3, "z": 4}) {
+		t.Errorf("Merge() = %v, want map[x:1 y:3 z:4]", result)
+	}
+
+	var conflicts []string
+	sum := func(k string, current, next int) int {
+		conflicts = append(conflicts, k)
+		return current + next
+	}
+	if result := MergeWith(sum, a, b, map[string]int{"y": 10}); !reflect.DeepEqual(result, map[string]int{"x": 1, "y": 15, "z": 4}) {
+		t.Errorf("MergeWith() = %v, want map[x:1 y:15 z:4]", result)
+	}
+	if !reflect.DeepEqual(conflicts, []string{"y", "y"}) {
+		t.Errorf("MergeWith() resolved %v, want [y y]", conflicts)
+	}
+	if !reflect.DeepEqual(a, map[string]int{"x": 1, "y": 2}) {
+		t.Errorf("Merge() modified the input map: %v", a)
+	}
+	if result := Merge[string, int](); result == nil || len(result) != 0 {
+		t.Errorf("Merge() without maps = %v, want empty map", result)
+	}
+}
+
+func TestGetOr(t *testing.T) {
+	m := map[string]int{"a": 0}
+	if v := GetOr(m, "a", 5); v != 0 {
+		t.Errorf("GetOr() = %v, want 0", v)
+	}
+	if v := GetOr(m, "b", 5); v != 5 {
+		t.Errorf("GetOr() = %v, want 5", v)
+	}
+	if v := GetOr(nil, "b", 5); v != 5 {
+		t.Errorf("GetOr() on nil map = %v, want 5", v)
+	}
+}
+
+func TestRangeSorted(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+	var visited []string
+	RangeSorted(m, gvalue.Less[string], func(k string, v int) bool {
+		visited = append(visited, k)
+		return v < 2
+	})
+	if !reflect.DeepEqual(visited, []string{"a", "b"}) {
+		t.Errorf("RangeSorted() visited %v, want [a b]", visited)
+	}
+}
@@ -0,0 +1,297 @@
+package gmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	tgjson "github.com/tidwall/gjson"
+
+	"github.com/geebos/gocraft/pkg/gjson"
+	"github.com/geebos/gocraft/pkg/gslice"
+)
+
+// OrderedMap is a map that remembers the insertion order of its keys.
+//
+// Setting an existing key keeps its original position. The zero value is an
+// empty map ready to use. OrderedMap is not safe for concurrent use.
+//
+// OrderedMap encodes to and decodes from a JSON object whose keys keep their
+// order. Keys follow the rules of encoding/json: they must be strings,
+// integers or implement encoding.TextMarshaler and encoding.TextUnmarshaler.
+//
+// Example:
+//
+//	m := NewOrderedMap[string, int]()
+//	m.Set("b", 2)
+//	m.Set("a", 1)
+//	gjson.Dumps(m) // {"b":2,"a":1}
+type OrderedMap[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+	index  map[K]int
+}
+
+// NewOrderedMap creates an empty ordered map.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{values: make(map[K]V), index: make(map[K]int)}
+}
+
+// Set sets the value for key. A new key is appended at the end.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if m.values == nil {
+		m.values = make(map[K]V)
+		m.index = make(map[K]int)
+	}
+	if _, ok := m.index[key]; !ok {
+		m.index[key] = len(m.keys)
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Get returns the value for key and whether it is present.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if m == nil {
+		var zero V
+		return zero, false
+	}
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// Has reports whether key is present.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Delete removes key and reports whether it was present.
+//
+// Delete keeps the order of the remaining keys and runs in O(n).
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	i, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	delete(m.values, key)
+	delete(m.index, key)
+	m.keys = gslice.StealRemoveAt(m.keys, i)
+	for j := i; j < len(m.keys); j++ {
+		m.index[m.keys[j]] = j
+	}
+	return true
+}
+
+// Len returns the number of entries.
+func (m *OrderedMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.keys)
+}
+
+// Clear removes all entries.
+func (m *OrderedMap[K, V]) Clear() {
+	m.keys = nil
+	m.values = make(map[K]V)
+	m.index = make(map[K]int)
+}
+
+// Keys returns the keys in insertion order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	result := make([]K, m.Len())
+	if m != nil {
+		copy(result, m.keys)
+	}
+	return result
+}
+
+// Values returns the values in insertion order of their keys.
+func (m *OrderedMap[K, V]) Values() []V {
+	result := make([]V, 0, m.Len())
+	m.Range(func(_ K, v V) bool {
+		result = append(result, v)
+		return true
+	})
+	return result
+}
+
+// Entries returns the key-value pairs in insertion order.
+func (m *OrderedMap[K, V]) Entries() []gslice.Pair[K, V] {
+	result := make([]gslice.Pair[K, V], 0, m.Len())
+	m.Range(func(k K, v V) bool {
+		result = append(result, gslice.Pair[K, V]{First: k, Second: v})
+		return true
+	})
+	return result
+}
+
+// Range calls fn for each entry in insertion order.
+// If fn returns false, Range stops the iteration.
+func (m *OrderedMap[K, V]) Range(fn func(key K, value V) bool) {
+	if m == nil {
+		return
+	}
+	for _, k := range m.keys {
+		if !fn(k, m.values[k]) {
+			return
+		}
+	}
+}
+
+// Clone returns a shallow copy of the map.
+func (m *OrderedMap[K, V]) Clone() *OrderedMap[K, V] {
+	result := NewOrderedMap[K, V]()
+	m.Range(func(k K, v V) bool {
+		result.Set(k, v)
+		return true
+	})
+	return result
+}
+
+// MarshalJSON encodes the map as a JSON object in insertion order.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := encodeKey(k)
+		if err != nil {
+			return nil, err
+		}
+		key, err := gjson.Marshal[[]byte](name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := gjson.Marshal[[]byte](m.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalOrderedMap parses a JSON object into a new [OrderedMap] as
+// described in [OrderedMap.UnmarshalJSON], decoding the values with opts.
+//
+// Use it instead of gjson.Unmarshal when decoder settings such as
+// gjson.WithDisableUnknownFields must apply to the values. Limits such as
+// gjson.WithMaxDepth are checked on the whole object.
+//
+// Example:
+//
+//	m, err := UnmarshalOrderedMap[string, User](data, gjson.WithDisableUnknownFields())
+func UnmarshalOrderedMap[K comparable, V any, D ~[]byte | ~string](data D, opts ...gjson.DecodeOption) (*OrderedMap[K, V], error) {
+	if len(opts) > 0 {
+		if _, err := gjson.Unmarshal[json.RawMessage](data, opts...); err != nil {
+			return nil, err
+		}
+	}
+	m := NewOrderedMap[K, V]()
+	if err := m.decode([]byte(data), opts); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map in the order of its keys,
+// replacing its contents. If a key occurs several times, the last value
+// wins and the key keeps its first position. JSON null clears the map.
+//
+// Values are decoded with the default settings, because encoding/json does
+// not pass decoder settings to UnmarshalJSON. Use [UnmarshalOrderedMap] to
+// decode a map with options.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	return m.decode(data, nil)
+}
+
+// decode replaces the contents of m with the JSON object data, decoding the values with opts.
+func (m *OrderedMap[K, V]) decode(data []byte, opts []gjson.DecodeOption) error {
+	if !tgjson.ValidBytes(data) {
+		return gjson.ErrInvalidJSON
+	}
+	result := tgjson.ParseBytes(data)
+	if result.Type == tgjson.Null {
+		m.Clear()
+		return nil
+	}
+	if !result.IsObject() {
+		return fmt.Errorf("gmap: cannot unmarshal %s into OrderedMap", result.Type)
+	}
+
+	m.Clear()
+	var err error
+	result.ForEach(func(key, value tgjson.Result) bool {
+		var k K
+		if k, err = decodeKey[K](key.String()); err != nil {
+			return false
+		}
+		var v V
+		if v, err = gjson.Unmarshal[V](value.Raw, opts...); err != nil {
+			err = fmt.Errorf("`%s` %w", key.String(), err)
+			return false
+		}
+		m.Set(k, v)
+		return true
+	})
+	return err
+}
+
+// encodeKey converts a map key to a JSON object key as encoding/json does.
+func encodeKey[K comparable](key K) (string, error) {
+	rv := reflect.ValueOf(&key).Elem()
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("gmap: unsupported key type %s", rv.Type())
+}
+
+// decodeKey converts a JSON object key to a map key as encoding/json does.
+func decodeKey[K comparable](s string) (K, error) {
+	var key K
+	rv := reflect.ValueOf(&key).Elem()
+	if rv.Kind() != reflect.String {
+		if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+			return key, tu.UnmarshalText([]byte(s))
+		}
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("`%s` %w", s, err)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("`%s` %w", s, err)
+		}
+		rv.SetUint(n)
+	default:
+		return key, fmt.Errorf("gmap: unsupported key type %s", rv.Type())
+	}
+	return key, nil
+}
@@ -0,0 +1,140 @@
+package gmap
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/geebos/gocraft/pkg/gjson"
+)
+
+func TestOrderedMapBasic(t *testing.T) {
+	var m OrderedMap[string, int]
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 10)
+
+	if !reflect.DeepEqual(m.Keys(), []string{"c", "a", "b"}) {
+		t.Errorf("Keys() = %v, want [c a b]", m.Keys())
+	}
+	if !reflect.DeepEqual(m.Values(), []int{3, 10, 2}) {
+		t.Errorf("Values() = %v, want [3 10 2]", m.Values())
+	}
+	if v, ok := m.Get("a"); v != 10 || !ok {
+		t.Errorf("Get() = %v, %v, want 10, true", v, ok)
+	}
+	if m.Has("z") || m.Len() != 3 {
+		t.Errorf("Has() or Len() returned unexpected result")
+	}
+
+	if !m.Delete("c") || m.Delete("c") {
+		t.Errorf("Delete() returned unexpected result")
+	}
+	m.Set("c", 4)
+	if !reflect.DeepEqual(m.Keys(), []string{"a", "b", "c"}) {
+		t.Errorf("Keys() after Delete() = %v, want [a b c]", m.Keys())
+	}
+	if v, _ := m.Get("b"); v != 2 {
+		t.Errorf("Get() after Delete() = %v, want 2", v)
+	}
+
+	clone := m.Clone()
+	clone.Set("d", 5)
+	if m.Len() != 3 || clone.Len() != 4 {
+		t.Errorf("Clone() shares state with the original map")
+	}
+
+	var visited []string
+	m.Range(func(k string, _ int) bool {
+		visited = append(visited, k)
+		return len(visited) < 2
+	})
+	if !reflect.DeepEqual(visited, []string{"a", "b"}) {
+		t.Errorf("Range() visited %v, want [a b]", visited)
+	}
+
+	m.Clear()
+	if m.Len() != 0 || len(m.Entries()) != 0 {
+		t.Errorf("Clear() did not remove all entries")
+	}
+
+	var nilMap *OrderedMap[string, int]
+	if nilMap.Len() != 0 || nilMap.Has("a") || len(nilMap.Keys()) != 0 {
+		t.Errorf("nil OrderedMap is not empty")
+	}
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	m := NewOrderedMap[string, []int]()
+	m.Set("z", []int{1})
+	m.Set("a", nil)
+	m.Set("m", []int{2, 3})
+
+	data := gjson.Dumps(m)
+	if data != `{"z":[1],"a":null,"m":[2,3]}` {
+		t.Errorf("Dumps() = %v, want keys in insertion order", data)
+	}
+
+	decoded, err := gjson.Unmarshal[*OrderedMap[string, []int]](`{"y":[1],"b":[2],"y":[3]}`)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Keys(), []string{"y", "b"}) {
+		t.Errorf("Unmarshal() keys = %v, want [y b]", decoded.Keys())
+	}
+	if v, _ := decoded.Get("y"); !reflect.DeepEqual(v, []int{3}) {
+		t.Errorf("Unmarshal() y = %v, want [3]", v)
+	}
+
+	type wrapper struct {
+		Counts OrderedMap[int, string] `json:"counts"`
+	}
+	w, err := gjson.Unmarshal[wrapper](`{"counts":{"3":"c","1":"a"}}`)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(w.Counts.Keys(), []int{3, 1}) {
+		t.Errorf("Unmarshal() keys = %v, want [3 1]", w.Counts.Keys())
+	}
+	if data := gjson.Dumps(w); data != `{"counts":{"3":"c","1":"a"}}` {
+		t.Errorf("Dumps() = %v, want integer keys in order", data)
+	}
+
+	errorCases := []string{`[1]`, `{"a":"x"}`, `{`}
+	for _, input := range errorCases {
+		if _, err := gjson.Unmarshal[OrderedMap[string, int]](input); err == nil {
+			t.Errorf("Unmarshal(%s) error = nil, want error", input)
+		}
+	}
+	if _, err := gjson.Unmarshal[OrderedMap[int, int]](`{"x":1}`); err == nil {
+		t.Errorf("Unmarshal() with invalid integer key error = nil, want error")
+	}
+}
+
+func TestUnmarshalOrderedMap(t *testing.T) {
+	type user struct {
+		Name string `json:"name"`
+	}
+	data := `{"b":{"name":"bob"},"a":{"name":"alice","age":3}}`
+
+	if _, err := gjson.Unmarshal[*OrderedMap[string, user]](data, gjson.WithDisableUnknownFields()); err != nil {
+		t.Errorf("Unmarshal() error = %v, want nil as options do not reach UnmarshalJSON", err)
+	}
+	if _, err := UnmarshalOrderedMap[string, user](data, gjson.WithDisableUnknownFields()); err == nil {
+		t.Errorf("UnmarshalOrderedMap() with unknown field error = nil, want error")
+	}
+	if _, err := UnmarshalOrderedMap[string, user](data, gjson.WithMaxDepth(1)); err == nil {
+		t.Errorf("UnmarshalOrderedMap() with depth limit error = nil, want error")
+	}
+
+	m, err := UnmarshalOrderedMap[string, user]([]byte(data))
+	if err != nil {
+		t.Fatalf("UnmarshalOrderedMap() error = %v", err)
+	}
+	if !reflect.DeepEqual(m.Keys(), []string{"b", "a"}) {
+		t.Errorf("UnmarshalOrderedMap() keys = %v, want [b a]", m.Keys())
+	}
+	if v, _ := m.Get("a"); v.Name != "alice" {
+		t.Errorf("UnmarshalOrderedMap() a = %v, want alice", v)
+	}
+}
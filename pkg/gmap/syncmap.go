@@ -0,0 +1,93 @@
+package gmap
+
+import "sync"
+
+// SyncMap is a typed wrapper of sync.Map that is safe for concurrent use.
+//
+// The zero value is an empty map ready to use. A SyncMap must not be copied
+// after first use. Nil values of interface types can be stored and loaded.
+//
+// Example:
+//
+//	var m SyncMap[string, int]
+//	m.Store("a", 1)
+//	v, ok := m.Load("a") // 1, true
+type SyncMap[K comparable, V any] struct {
+	m sync.Map
+}
+
+// Load returns the value stored for key, or the zero value and false if
+// there is none.
+func (m *SyncMap[K, V]) Load(key K) (V, bool) {
+	v, ok := m.m.Load(key)
+	value, _ := v.(V)
+	return value, ok
+}
+
+// Store sets the value for key.
+func (m *SyncMap[K, V]) Store(key K, value V) {
+	m.m.Store(key, value)
+}
+
+// LoadOrStore returns the existing value for key if present and true.
+// Otherwise, it stores value and returns it and false.
+func (m *SyncMap[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	v, loaded := m.m.LoadOrStore(key, value)
+	actual, _ := v.(V)
+	return actual, loaded
+}
+
+// LoadAndDelete deletes the value for key and returns the previous value
+// and whether it was present.
+func (m *SyncMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	v, loaded := m.m.LoadAndDelete(key)
+	value, _ := v.(V)
+	return value, loaded
+}
+
+// Delete deletes the value for key.
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.m.Delete(key)
+}
+
+// Range calls fn for each key and value in the map in unspecified order.
+// If fn returns false, Range stops the iteration.
+//
+// Range has the same consistency guarantees as sync.Map.Range.
+func (m *SyncMap[K, V]) Range(fn func(key K, value V) bool) {
+	m.m.Range(func(k, v any) bool {
+		// The comma-ok form accepts nil interface keys and values.
+		key, _ := k.(K)
+		value, _ := v.(V)
+		return fn(key, value)
+	})
+}
+
+// Len returns the number of entries in the map. It iterates over the map
+// and may not reflect concurrent modifications.
+func (m *SyncMap[K, V]) Len() int {
+	n := 0
+	m.m.Range(func(_, _ any) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// Clear deletes all entries of the map.
+func (m *SyncMap[K, V]) Clear() {
+	m.m.Range(func(k, _ any) bool {
+		m.m.Delete(k)
+		return true
+	})
+}
+
+// ToMap returns a snapshot of the map as a built-in map.
+func (m *SyncMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V)
+	m.Range(func(k K, v V) bool {
+		result[k] = v
+		return true
+	})
+	return result
+}
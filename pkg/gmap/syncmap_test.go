@@ -0,0 +1,84 @@
+package gmap
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSyncMap(t *testing.T) {
+	var m SyncMap[string, int]
+	if _, ok := m.Load("a"); ok {
+		t.Errorf("Load() on empty map returned ok = true")
+	}
+
+	m.Store("a", 1)
+	if v, ok := m.Load("a"); v != 1 || !ok {
+		t.Errorf("Load() = %v, %v, want 1, true", v, ok)
+	}
+	if v, loaded := m.LoadOrStore("a", 2); v != 1 || !loaded {
+		t.Errorf("LoadOrStore() = %v, %v, want 1, true", v, loaded)
+	}
+	if v, loaded := m.LoadOrStore("b", 2); v != 2 || loaded {
+		t.Errorf("LoadOrStore() = %v, %v, want 2, false", v, loaded)
+	}
+	if m.Len() != 2 || !reflect.DeepEqual(m.ToMap(), map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("ToMap() = %v, want map[a:1 b:2]", m.ToMap())
+	}
+	if v, loaded := m.LoadAndDelete("a"); v != 1 || !loaded {
+		t.Errorf("LoadAndDelete() = %v, %v, want 1, true", v, loaded)
+	}
+	if _, loaded := m.LoadAndDelete("a"); loaded {
+		t.Errorf("LoadAndDelete() on missing key returned loaded = true")
+	}
+
+	m.Delete("b")
+	m.Store("c", 3)
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("Len() after Clear() = %v, want 0", m.Len())
+	}
+}
+
+func TestSyncMapNilInterface(t *testing.T) {
+	var m SyncMap[string, error]
+	m.Store("a", nil)
+	if v, ok := m.Load("a"); v != nil || !ok {
+		t.Errorf("Load() = %v, %v, want nil, true", v, ok)
+	}
+	if v, loaded := m.LoadOrStore("a", errors.New("x")); v != nil || !loaded {
+		t.Errorf("LoadOrStore() = %v, %v, want nil, true", v, loaded)
+	}
+	if got := m.ToMap(); !reflect.DeepEqual(got, map[string]error{"a": nil}) {
+		t.Errorf("ToMap() = %v, want map[a:<nil>]", got)
+	}
+	if v, loaded := m.LoadAndDelete("a"); v != nil || !loaded {
+		t.Errorf("LoadAndDelete() = %v, %v, want nil, true", v, loaded)
+	}
+}
+
+func TestSyncMapConcurrent(t *testing.T) {
+	var m SyncMap[int, int]
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Store(i*100+j, j)
+				m.Load(j)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	sum := 0
+	m.Range(func(_, v int) bool {
+		sum += v
+		return true
+	})
+	if m.Len() != 800 || sum != 8*4950 {
+		t.Errorf("SyncMap has %v entries with sum %v, want 800 and %v", m.Len(), sum, 8*4950)
+	}
+}
@@ -0,0 +1,281 @@
+package gslice
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+// ErrOverflow is returned when an integer aggregate does not fit in its type.
+var ErrOverflow = fmt.Errorf("integer overflow")
+
+// Sum returns the sum of the elements of the slice.
+//
+// Integer sums return [ErrOverflow] if the result does not fit in T. Float
+// sums use Kahan-Babuska summation to limit rounding errors. The sum of an
+// empty slice is 0.
+//
+// Example:
+//
+//	total, err := Sum([]int{1, 2, 3})
+//	// total is 6
+func Sum[T gvalue.Number](s []T) (T, error) {
+	return SumBy(s, func(v T) T { return v })
+}
+
+// SumBy returns the sum of the values returned by fn for each element.
+//
+// SumBy behaves like [Sum].
+//
+// Example:
+//
+//	total, err := SumBy(orders, func(o Order) int64 { return o.Cents })
+func SumBy[T any, N gvalue.Number](s []T, fn func(T) N) (N, error) {
+	if isFloat[N]() {
+		return N(kahanSum(s, func(v T) float64 { return float64(fn(v)) })), nil
+	}
+
+	var sum N
+	for i, v := range s {
+		n := fn(v)
+		next := sum + n
+		if (n > 0 && next < sum) || (n < 0 && next > sum) {
+			return 0, &IndexError{Index: i, Err: ErrOverflow}
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// ProductOf returns the product of the elements of the slice.
+//
+// Integer products return [ErrOverflow] if the result does not fit in T. The
+// product of an empty slice is 1. For the cartesian product of two slices,
+// see [Product].
+//
+// Example:
+//
+//	p, err := ProductOf([]int{2, 3, 4})
+//	// p is 24
+func ProductOf[T gvalue.Number](s []T) (T, error) {
+	float := isFloat[T]()
+	product := T(1)
+	for i, v := range s {
+		next := product * v
+		if !float && ((product != 0 && next/product != v) || (v != 0 && next/v != product)) {
+			return 0, &IndexError{Index: i, Err: ErrOverflow}
+		}
+		product = next
+	}
+	return product, nil
+}
+
+// Mean returns the arithmetic mean of the elements of the slice.
+//
+// If the slice is empty, Mean returns 0 and false.
+//
+// Example:
+//
+//	mean, ok := Mean([]int{1, 2, 3, 4})
+//	// mean is 2.5, ok is true
+func Mean[T gvalue.Number](s []T) (float64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+	return kahanSum(s, func(v T) float64 { return float64(v) }) / float64(len(s)), true
+}
+
+// Median returns the median of the elements of the slice. For an even number
+// of elements it is the mean of the two middle elements.
+//
+// The input slice is not modified. If the slice is empty, Median returns 0
+// and false.
+//
+// Example:
+//
+//	median, ok := Median([]int{5, 1, 4, 2})
+//	// median is 3, ok is true
+func Median[T gvalue.Number](s []T) (float64, bool) {
+	return Percentile(s, 50)
+}
+
+// Percentile returns the p-th percentile of the elements of the slice, with
+// p between 0 and 100.
+//
+// Percentile interpolates linearly between the two closest ranks, so the
+// 0th percentile is the minimum, the 50th the median and the 100th the
+// maximum. The input slice is not modified. If the slice is empty or p is
+// outside [0, 100], Percentile returns 0 and false.
+//
+// Example:
+//
+//	p90, ok := Percentile(latencies, 90)
+func Percentile[T gvalue.Number](s []T, p float64) (float64, bool) {
+	if len(s) == 0 || !(p >= 0 && p <= 100) {
+		return 0, false
+	}
+	sorted := make([]float64, len(s))
+	for i, v := range s {
+		sorted[i] = float64(v)
+	}
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	if lower == len(sorted)-1 {
+		return sorted[lower], true
+	}
+	frac := rank - float64(lower)
+	return sorted[lower] + frac*(sorted[lower+1]-sorted[lower]), true
+}
+
+// Variance returns the population variance of the elements of the slice.
+//
+// Variance uses Welford's algorithm for numerical stability. If the slice is
+// empty, Variance returns 0 and false.
+//
+// Example:
+//
+//	variance, ok := Variance([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// variance is 4, ok is true
+func Variance[T gvalue.Number](s []T) (float64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+	var mean, m2 float64
+	for i, v := range s {
+		x := float64(v)
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+	return m2 / float64(len(s)), true
+}
+
+// StdDev returns the population standard deviation of the elements of the
+// slice, the square root of [Variance].
+//
+// If the slice is empty, StdDev returns 0 and false.
+//
+// Example:
+//
+//	stddev, ok := StdDev([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// stddev is 2, ok is true
+func StdDev[T gvalue.Number](s []T) (float64, bool) {
+	variance, ok := Variance(s)
+	return math.Sqrt(variance), ok
+}
+
+// Min returns the smallest element of the slice.
+//
+// If the slice is empty, Min returns the zero value and false.
+//
+// Example:
+//
+//	min, ok := Min([]int{3, 1, 2})
+//	// min is 1, ok is true
+func Min[T gvalue.Ordered](s []T) (T, bool) {
+	return MinBy(s, func(v T) T { return v })
+}
+
+// Max returns the largest element of the slice.
+//
+// If the slice is empty, Max returns the zero value and false.
+//
+// Example:
+//
+//	max, ok := Max([]int{3, 1, 2})
+//	// max is 3, ok is true
+func Max[T gvalue.Ordered](s []T) (T, bool) {
+	return MaxBy(s, func(v T) T { return v })
+}
+
+// MinMax returns the smallest and the largest element of the slice in a
+// single pass.
+//
+// If the slice is empty, MinMax returns zero values and false.
+//
+// Example:
+//
+//	min, max, ok := MinMax([]int{3, 1, 2})
+//	// min is 1, max is 3, ok is true
+func MinMax[T gvalue.Ordered](s []T) (T, T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, zero, false
+	}
+	smallest, largest := s[0], s[0]
+	for _, v := range s[1:] {
+		if v < smallest {
+			smallest = v
+		}
+		if v > largest {
+			largest = v
+		}
+	}
+	return smallest, largest, true
+}
+
+// MinBy returns the element of the slice with the smallest key, as returned
+// by keyFn. The first such element wins on ties.
+//
+// If the slice is empty, MinBy returns the zero value and false.
+//
+// Example:
+//
+//	youngest, ok := MinBy(users, func(u User) int { return u.Age })
+func MinBy[T any, K gvalue.Ordered](s []T, keyFn func(T) K) (T, bool) {
+	return extremeBy(s, keyFn, gvalue.Less[K])
+}
+
+// MaxBy returns the element of the slice with the largest key, as returned
+// by keyFn. The first such element wins on ties.
+//
+// If the slice is empty, MaxBy returns the zero value and false.
+//
+// Example:
+//
+//	oldest, ok := MaxBy(users, func(u User) int { return u.Age })
+func MaxBy[T any, K gvalue.Ordered](s []T, keyFn func(T) K) (T, bool) {
+	return extremeBy(s, keyFn, gvalue.GT[K])
+}
+
+// extremeBy returns the first element whose key is better than the keys of
+// all following elements according to better.
+func extremeBy[T any, K gvalue.Ordered](s []T, keyFn func(T) K, better func(K, K) bool) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	result, best := s[0], keyFn(s[0])
+	for _, v := range s[1:] {
+		if key := keyFn(v); better(key, best) {
+			result, best = v, key
+		}
+	}
+	return result, true
+}
+
+// kahanSum returns the sum of fn over s using Kahan-Babuska (Neumaier)
+// compensated summation.
+func kahanSum[T any](s []T, fn func(T) float64) float64 {
+	var sum, compensation float64
+	for _, v := range s {
+		x := fn(v)
+		t := sum + x
+		if math.Abs(sum) >= math.Abs(x) {
+			compensation += (sum - t) + x
+		} else {
+			compensation += (x - t) + sum
+		}
+		sum = t
+	}
+	return sum + compensation
+}
+
+// isFloat reports whether T is a floating-point type.
+func isFloat[T gvalue.Number]() bool {
+	return T(1)/T(2) != 0
+}
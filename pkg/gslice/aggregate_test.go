@@ -0,0 +1,178 @@
+package gslice
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	if sum, err := Sum([]int{1, 2, 3}); sum != 6 || err != nil {
+		t.Errorf("Sum() = %v, %v, want 6, nil", sum, err)
+	}
+	if sum, err := Sum([]int{}); sum != 0 || err != nil {
+		t.Errorf("Sum() on empty slice = %v, %v, want 0, nil", sum, err)
+	}
+	if sum, err := Sum([]int8{100, -50, 70}); sum != 120 || err != nil {
+		t.Errorf("Sum() = %v, %v, want 120, nil", sum, err)
+	}
+
+	tests := []struct {
+		name string
+		sum  func() error
+	}{
+		{name: "int8 positive", sum: func() error { _, err := Sum([]int8{100, 27, 1}); return err }},
+		{name: "int8 negative", sum: func() error { _, err := Sum([]int8{-100, -28, -1}); return err }},
+		{name: "uint8", sum: func() error { _, err := Sum([]uint8{200, 56}); return err }},
+		{name: "int64", sum: func() error { _, err := Sum([]int64{math.MaxInt64, 1}); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.sum(); !errors.Is(err, ErrOverflow) {
+				t.Errorf("Sum() error = %v, want %v", err, ErrOverflow)
+			}
+		})
+	}
+
+	floats := make([]float64, 0, 10001)
+	floats = append(floats, 1)
+	for i := 0; i < 10000; i++ {
+		floats = append(floats, 1e-16)
+	}
+	if sum, _ := Sum(floats); math.Abs(sum-(1+1e-12)) > 1e-20 {
+		t.Errorf("Sum() with Kahan summation = %v, want %v", sum, 1+1e-12)
+	}
+	if sum, _ := Sum([]float64{1e100, 1, -1e100}); sum != 1 {
+		t.Errorf("Sum() = %v, want 1", sum)
+	}
+}
+
+func TestSumBy(t *testing.T) {
+	users := []sortUser{{"amy", 25}, {"bob", 30}}
+	if sum, err := SumBy(users, func(u sortUser) int { return u.Age }); sum != 55 || err != nil {
+		t.Errorf("SumBy() = %v, %v, want 55, nil", sum, err)
+	}
+	_, err := SumBy([]int{1, 127}, func(n int) int8 { return int8(n) })
+	checkIndexError(t, "SumBy()", err, 1)
+}
+
+func TestProductOf(t *testing.T) {
+	if p, err := ProductOf([]int{2, 3, 4}); p != 24 || err != nil {
+		t.Errorf("ProductOf() = %v, %v, want 24, nil", p, err)
+	}
+	if p, err := ProductOf([]int{}); p != 1 || err != nil {
+		t.Errorf("ProductOf() on empty slice = %v, %v, want 1, nil", p, err)
+	}
+	if p, err := ProductOf([]float64{0.5, 3}); p != 1.5 || err != nil {
+		t.Errorf("ProductOf() = %v, %v, want 1.5, nil", p, err)
+	}
+	if p, err := ProductOf([]int8{-1, 0, -128}); p != 0 || err != nil {
+		t.Errorf("ProductOf() = %v, %v, want 0, nil", p, err)
+	}
+	if _, err := ProductOf([]int8{16, 8}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("ProductOf() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := ProductOf([]int8{-1, -128}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("ProductOf() error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	tests := []struct {
+		name     string
+		stat     func([]int) (float64, bool)
+		input    []int
+		expected float64
+	}{
+		{name: "mean", stat: Mean[int], input: []int{1, 2, 3, 4}, expected: 2.5},
+		{name: "median odd", stat: Median[int], input: []int{5, 1, 3}, expected: 3},
+		{name: "median even", stat: Median[int], input: []int{5, 1, 4, 2}, expected: 3},
+		{name: "variance", stat: Variance[int], input: []int{2, 4, 4, 4, 5, 5, 7, 9}, expected: 4},
+		{name: "stddev", stat: StdDev[int], input: []int{2, 4, 4, 4, 5, 5, 7, 9}, expected: 2},
+		{name: "variance of one", stat: Variance[int], input: []int{7}, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := tt.stat(tt.input)
+			if !ok || math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("%s = %v, %v, want %v, true", tt.name, result, ok, tt.expected)
+			}
+			if _, ok := tt.stat(nil); ok {
+				t.Errorf("%s on empty slice returned ok = true", tt.name)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	input := []int{15, 20, 35, 40, 50}
+
+	tests := []struct {
+		name     string
+		p        float64
+		expected float64
+		ok       bool
+	}{
+		{name: "min", p: 0, expected: 15, ok: true},
+		{name: "max", p: 100, expected: 50, ok: true},
+		{name: "median", p: 50, expected: 35, ok: true},
+		{name: "interpolated", p: 40, expected: 29, ok: true},
+		{name: "out of range", p: 101, expected: 0, ok: false},
+		{name: "nan", p: math.NaN(), expected: 0, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := Percentile(input, tt.p)
+			if ok != tt.ok || math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("Percentile() = %v, %v, want %v, %v", result, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+
+	if input[0] != 15 || input[4] != 50 {
+		t.Errorf("Percentile() modified the input slice: %v", input)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	input := []int{3, 1, 4, 1, 5}
+	if v, ok := Min(input); v != 1 || !ok {
+		t.Errorf("Min() = %v, %v, want 1, true", v, ok)
+	}
+	if v, ok := Max(input); v != 5 || !ok {
+		t.Errorf("Max() = %v, %v, want 5, true", v, ok)
+	}
+	if lo, hi, ok := MinMax(input); lo != 1 || hi != 5 || !ok {
+		t.Errorf("MinMax() = %v, %v, %v, want 1, 5, true", lo, hi, ok)
+	}
+	if v, ok := Min([]string{"b", "a"}); v != "a" || !ok {
+		t.Errorf("Min() = %v, %v, want a, true", v, ok)
+	}
+
+	if _, ok := Min([]int{}); ok {
+		t.Errorf("Min() on empty slice returned ok = true")
+	}
+	if _, ok := Max[int](nil); ok {
+		t.Errorf("Max() on nil slice returned ok = true")
+	}
+	if _, _, ok := MinMax[int](nil); ok {
+		t.Errorf("MinMax() on nil slice returned ok = true")
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	users := []sortUser{{"bob", 30}, {"amy", 25}, {"cat", 30}, {"dan", 25}}
+	byAge := func(u sortUser) int { return u.Age }
+
+	if u, ok := MinBy(users, byAge); u.Name != "amy" || !ok {
+		t.Errorf("MinBy() = %v, %v, want amy, true", u, ok)
+	}
+	if u, ok := MaxBy(users, byAge); u.Name != "bob" || !ok {
+		t.Errorf("MaxBy() = %v, %v, want bob, true", u, ok)
+	}
+	if _, ok := MinBy(nil, byAge); ok {
+		t.Errorf("MinBy() on nil slice returned ok = true")
+	}
+}
@@ -0,0 +1,104 @@
+package gslice
+
+// Chunk splits the slice into chunks of size elements.
+//
+// The last chunk holds the remaining elements and may be shorter. Each chunk
+// is a new slice that does not share memory with the input, like [Slice].
+// Chunk panics if size is less than 1.
+//
+// Example:
+//
+//	chunks := Chunk([]int{1, 2, 3, 4, 5}, 2)
+//	// chunks is [][]int{{1, 2}, {3, 4}, {5}}
+func Chunk[T any](s []T, size int) [][]T {
+	if size < 1 {
+		panic("gslice: chunk size must be positive")
+	}
+	if s == nil {
+		return nil
+	}
+	result := make([][]T, 0, (len(s)+size-1)/size)
+	for start := 0; start < len(s); start += size {
+		end := start + size
+		if end > len(s) {
+			end = len(s)
+		}
+		result = append(result, Slice(s, start, end))
+	}
+	return result
+}
+
+// ChunkBy splits the slice into consecutive chunks whose total weight, as
+// returned by weightFn, does not exceed maxWeight.
+//
+// Elements are added to the current chunk until the next one would exceed
+// maxWeight. An element heavier than maxWeight is placed in a chunk of its
+// own. Each chunk is a new slice that does not share memory with the input.
+//
+// Example:
+//
+//	rows := []string{"aaa", "bb", "cccc", "d"}
+//	batches := ChunkBy(rows, func(s string) int { return len(s) }, 5)
+//	// batches is [][]string{{"aaa", "bb"}, {"cccc", "d"}}
+func ChunkBy[T any](s []T, weightFn func(T) int, maxWeight int) [][]T {
+	if s == nil {
+		return nil
+	}
+	result := make([][]T, 0)
+	start, weight := 0, 0
+	for i, v := range s {
+		w := weightFn(v)
+		if i > start && weight+w > maxWeight {
+			result = append(result, Slice(s, start, i))
+			start, weight = i, 0
+		}
+		weight += w
+	}
+	if start < len(s) {
+		result = append(result, Slice(s, start, len(s)))
+	}
+	return result
+}
+
+// Window returns the sliding windows of size elements, starting every step
+// elements.
+//
+// Only full windows are returned, so a slice shorter than size yields no
+// windows. Each window is a new slice that does not share memory with the
+// input. Window panics if size or step is less than 1.
+//
+// Example:
+//
+//	windows := Window([]int{1, 2, 3, 4, 5}, 3, 1)
+//	// windows is [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+func Window[T any](s []T, size, step int) [][]T {
+	if size < 1 || step < 1 {
+		panic("gslice: window size and step must be positive")
+	}
+	if s == nil {
+		return nil
+	}
+	result := make([][]T, 0)
+	for start := 0; start+size <= len(s); start += step {
+		result = append(result, Slice(s, start, start+size))
+	}
+	return result
+}
+
+// Flatten concatenates the slices of s into a single new slice.
+//
+// Flatten is the inverse of [Chunk]. If s is nil, Flatten returns nil.
+//
+// Example:
+//
+//	flat := Flatten([][]int{{1, 2}, {3}, {4, 5}})
+//	// flat is []int{1, 2, 3, 4, 5}
+func Flatten[T any](s [][]T) []T {
+	if s == nil {
+		return nil
+	}
+	if len(s) == 0 {
+		return []T{}
+	}
+	return Concat(s...)
+}
@@ -0,0 +1,202 @@
+package gslice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "uneven",
+			input:    []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "size larger than slice",
+			input:    []int{1, 2},
+			size:     5,
+			expected: [][]int{{1, 2}},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			size:     2,
+			expected: nil,
+		},
+		{
+			name:     "empty slice",
+			input:    []int{},
+			size:     2,
+			expected: [][]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Chunk(tt.input, tt.size)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Chunk() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	input := []int{1, 2, 3}
+	chunks := Chunk(input, 2)
+	chunks[0][0] = 100
+	chunks[0] = append(chunks[0], 200)
+	if !reflect.DeepEqual(input, []int{1, 2, 3}) {
+		t.Errorf("Chunk() result shares memory with input: %v", input)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Chunk() with size 0 should panic")
+		}
+	}()
+	Chunk(input, 0)
+}
+
+func TestChunkBy(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	tests := []struct {
+		name      string
+		input     []string
+		maxWeight int
+		expected  [][]string
+	}{
+		{
+			name:      "fills batches",
+			input:     []string{"aaa", "bb", "cccc", "d"},
+			maxWeight: 5,
+			expected:  [][]string{{"aaa", "bb"}, {"cccc", "d"}},
+		},
+		{
+			name:      "oversized element gets own chunk",
+			input:     []string{"a", "bbbbbbb", "c"},
+			maxWeight: 3,
+			expected:  [][]string{{"a"}, {"bbbbbbb"}, {"c"}},
+		},
+		{
+			name:      "nil slice",
+			input:     nil,
+			maxWeight: 3,
+			expected:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ChunkBy(tt.input, length, tt.maxWeight)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ChunkBy() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		step     int
+		expected [][]int
+	}{
+		{
+			name:     "step one",
+			input:    []int{1, 2, 3, 4, 5},
+			size:     3,
+			step:     1,
+			expected: [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}},
+		},
+		{
+			name:     "step two drops partial window",
+			input:    []int{1, 2, 3, 4, 5, 6},
+			size:     3,
+			step:     2,
+			expected: [][]int{{1, 2, 3}, {3, 4, 5}},
+		},
+		{
+			name:     "shorter than size",
+			input:    []int{1, 2},
+			size:     3,
+			step:     1,
+			expected: [][]int{},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			size:     2,
+			step:     1,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Window(tt.input, tt.size, tt.step)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Window() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	input := []int{1, 2, 3}
+	windows := Window(input, 2, 1)
+	windows[0][1] = 100
+	if windows[1][0] != 2 || input[1] != 2 {
+		t.Errorf("Window() results share memory: %v, %v", windows, input)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Window() with step 0 should panic")
+		}
+	}()
+	Window(input, 2, 0)
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    [][]int
+		expected []int
+	}{
+		{
+			name:     "nested",
+			input:    [][]int{{1, 2}, nil, {3}, {4, 5}},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "empty slice",
+			input:    [][]int{},
+			expected: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Flatten(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Flatten() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	if result := Flatten(Chunk([]int{1, 2, 3}, 2)); !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Flatten(Chunk()) = %v, want [1 2 3]", result)
+	}
+}
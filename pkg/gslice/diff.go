@@ -0,0 +1,282 @@
+package gslice
+
+import "fmt"
+
+// DiffResult is the result of [DiffBy].
+type DiffResult[T any] struct {
+	// Added holds the elements of after whose key is not in before, in the order of after.
+	Added []T
+	// Removed holds the elements of before whose key is not in after, in the order of before.
+	Removed []T
+	// Changed holds the old and new element of keys in both slices whose
+	// elements are not equal, in the order of before.
+	Changed []Pair[T, T]
+	// Unchanged holds the elements of keys in both slices whose elements are
+	// equal, in the order of before.
+	Unchanged []T
+}
+
+// DiffBy compares two slices of records matched by the key returned by keyFn.
+//
+// Elements with the same key are compared with eq to decide whether they
+// changed. Keys are expected to be unique within each slice; only the first
+// element with a given key is considered.
+//
+// Example:
+//
+//	diff := DiffBy(actual, desired,
+//	    func(c Config) string { return c.Name },
+//	    func(a, b Config) bool { return a.Value == b.Value },
+//	)
+//	for _, c := range diff.Added {
+//	    create(c)
+//	}
+func DiffBy[T any, K comparable](before, after []T, keyFn func(T) K, eq func(T, T) bool) DiffResult[T] {
+	var result DiffResult[T]
+	afterByKey, _ := KeyBy(after, keyFn, KeepFirst)
+	seen := make(map[K]struct{}, len(before))
+	for _, v := range before {
+		key := keyFn(v)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		w, ok := afterByKey[key]
+		switch {
+		case !ok:
+			result.Removed = append(result.Removed, v)
+		case eq(v, w):
+			result.Unchanged = append(result.Unchanged, w)
+		default:
+			result.Changed = append(result.Changed, Pair[T, T]{First: v, Second: w})
+		}
+	}
+	for _, w := range after {
+		key := keyFn(w)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			result.Added = append(result.Added, w)
+		}
+	}
+	return result
+}
+
+// EditOp is the kind of an [Edit].
+type EditOp int
+
+const (
+	// EditKeep keeps an element present in both slices.
+	EditKeep EditOp = iota
+	// EditInsert inserts an element of the new slice.
+	EditInsert
+	// EditDelete deletes an element of the old slice.
+	EditDelete
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditKeep:
+		return "keep"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("EditOp(%d)", int(op))
+	}
+}
+
+// Edit is a single operation of an edit script, see [EditScript].
+//
+// OldIndex is the index of Value in the old slice and NewIndex its index in
+// the new slice. The index of the side the element is missing from is -1.
+type Edit[T any] struct {
+	Op       EditOp
+	Value    T
+	OldIndex int
+	NewIndex int
+}
+
+// String formats the edit as a line of a unified diff: "+ v" for insertions,
+// "- v" for deletions and "  v" for kept elements.
+func (e Edit[T]) String() string {
+	prefix := " "
+	switch e.Op {
+	case EditInsert:
+		prefix = "+"
+	case EditDelete:
+		prefix = "-"
+	}
+	return fmt.Sprintf("%s %v", prefix, e.Value)
+}
+
+// EditScript returns a shortest sequence of keep, insert and delete
+// operations that turns before into after.
+//
+// EditScript uses the linear space variant of Myers' diff algorithm and runs
+// in O((n+m)d) time and O(n+m) space, where d is the number of insertions and
+// deletions. Within a changed region, deletions come before insertions. If
+// both slices are nil, EditScript returns nil.
+//
+// Example:
+//
+//	script := EditScript([]string{"a", "b", "c"}, []string{"a", "c", "d"}, gvalue.Equal[string])
+//	for _, e := range script {
+//	    fmt.Println(e)
+//	}
+//	//   a
+//	// - b
+//	//   c
+//	// + d
+func EditScript[T any](before, after []T, eq func(T, T) bool) []Edit[T] {
+	if before == nil && after == nil {
+		return nil
+	}
+
+	n, m := len(before), len(after)
+	size := 2*((n+m+1)/2) + 3
+	e := &editScripter[T]{
+		before: before,
+		after:  after,
+		eq:     eq,
+		vf:     make([]int, size),
+		vb:     make([]int, size),
+		result: make([]Edit[T], 0, n+m),
+	}
+	e.diff(0, n, 0, m)
+	deletesFirst(e.result)
+	return e.result
+}
+
+// deletesFirst moves the deletions of every run of changes in the script
+// before its insertions. The middle snake search may interleave them.
+func deletesFirst[T any](script []Edit[T]) {
+	var buf []Edit[T]
+	for start := 0; start < len(script); start++ {
+		if script[start].Op == EditKeep {
+			continue
+		}
+		end := start
+		for end < len(script) && script[end].Op != EditKeep {
+			end++
+		}
+		buf = append(buf[:0], script[start:end]...)
+		i := start
+		for _, op := range []EditOp{EditDelete, EditInsert} {
+			for _, edit := range buf {
+				if edit.Op == op {
+					script[i] = edit
+					i++
+				}
+			}
+		}
+		start = end
+	}
+}
+
+// editScripter holds the state of [EditScript].
+type editScripter[T any] struct {
+	before, after []T
+	eq            func(T, T) bool
+	// vf and vb hold the furthest reaching x per diagonal of the forward and
+	// backward searches. They are shared by all recursive calls.
+	vf, vb []int
+	result []Edit[T]
+}
+
+// diff appends the edits that turn before[x0:x1] into after[y0:y1].
+func (e *editScripter[T]) diff(x0, x1, y0, y1 int) {
+	for x0 < x1 && y0 < y1 && e.eq(e.before[x0], e.after[y0]) {
+		e.keep(x0, y0)
+		x0++
+		y0++
+	}
+	suffixX := x1
+	for x0 < x1 && y0 < y1 && e.eq(e.before[x1-1], e.after[y1-1]) {
+		x1--
+		y1--
+	}
+
+	switch {
+	case x0 == x1:
+		for y := y0; y < y1; y++ {
+			e.result = append(e.result, Edit[T]{Op: EditInsert, Value: e.after[y], OldIndex: -1, NewIndex: y})
+		}
+	case y0 == y1:
+		for x := x0; x < x1; x++ {
+			e.result = append(e.result, Edit[T]{Op: EditDelete, Value: e.before[x], OldIndex: x, NewIndex: -1})
+		}
+	default:
+		startX, startY, endX, endY := e.middleSnake(x0, x1, y0, y1)
+		e.diff(x0, startX, y0, startY)
+		for x, y := startX, startY; x < endX; x, y = x+1, y+1 {
+			e.keep(x, y)
+		}
+		e.diff(endX, x1, endY, y1)
+	}
+
+	for x, y := x1, y1; x < suffixX; x, y = x+1, y+1 {
+		e.keep(x, y)
+	}
+}
+
+func (e *editScripter[T]) keep(x, y int) {
+	e.result = append(e.result, Edit[T]{Op: EditKeep, Value: e.after[y], OldIndex: x, NewIndex: y})
+}
+
+// middleSnake searches a shortest edit path from both ends of the box at
+// once and returns the snake where the two searches meet. Both sub-boxes
+// before and after the snake need fewer edits than the whole box.
+func (e *editScripter[T]) middleSnake(x0, x1, y0, y1 int) (startX, startY, endX, endY int) {
+	n, m := x1-x0, y1-y0
+	delta := n - m
+	limit := (n + m + 1) / 2
+	offset := limit + 1
+	vf, vb := e.vf, e.vb
+	vf[offset+1], vb[offset+1] = 0, 0
+
+	for d := 0; d <= limit; d++ {
+		// Forward search from (x0, y0). Coordinates are relative to the box.
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && vf[offset+k-1] < vf[offset+k+1]) {
+				x = vf[offset+k+1]
+			} else {
+				x = vf[offset+k-1] + 1
+			}
+			y := x - k
+			sx, sy := x, y
+			for x < n && y < m && e.eq(e.before[x0+x], e.after[y0+y]) {
+				x++
+				y++
+			}
+			vf[offset+k] = x
+			if kb := delta - k; delta%2 != 0 && kb >= -(d-1) && kb <= d-1 && n-vb[offset+kb] <= x {
+				return x0 + sx, y0 + sy, x0 + x, y0 + y
+			}
+		}
+
+		// Backward search from (x1, y1). Coordinates are measured from the
+		// end of the box.
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && vb[offset+k-1] < vb[offset+k+1]) {
+				x = vb[offset+k+1]
+			} else {
+				x = vb[offset+k-1] + 1
+			}
+			y := x - k
+			sx, sy := x, y
+			for x < n && y < m && e.eq(e.before[x1-1-x], e.after[y1-1-y]) {
+				x++
+				y++
+			}
+			vb[offset+k] = x
+			if kf := delta - k; delta%2 == 0 && kf >= -d && kf <= d && n-x <= vf[offset+kf] {
+				return x1 - x, y1 - y, x1 - sx, y1 - sy
+			}
+		}
+	}
+	panic("gslice: no middle snake found")
+}
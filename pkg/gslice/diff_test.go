@@ -0,0 +1,166 @@
+package gslice
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+func TestDiffBy(t *testing.T) {
+	before := []sortUser{{"amy", 25}, {"bob", 30}, {"cat", 35}, {"amy", 99}}
+	after := []sortUser{{"dan", 40}, {"cat", 36}, {"amy", 25}, {"eve", 20}}
+	byName := func(u sortUser) string { return u.Name }
+	eq := func(a, b sortUser) bool { return a == b }
+
+	result := DiffBy(before, after, byName, eq)
+	expected := DiffResult[sortUser]{
+		Added:     []sortUser{{"dan", 40}, {"eve", 20}},
+		Removed:   []sortUser{{"bob", 30}},
+		Changed:   []Pair[sortUser, sortUser]{{sortUser{"cat", 35}, sortUser{"cat", 36}}},
+		Unchanged: []sortUser{{"amy", 25}},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("DiffBy() = %+v, want %+v", result, expected)
+	}
+
+	if result := DiffBy(nil, nil, byName, eq); !reflect.DeepEqual(result, DiffResult[sortUser]{}) {
+		t.Errorf("DiffBy() on nil slices = %+v, want empty result", result)
+	}
+}
+
+func TestEditScript(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   string
+		after    string
+		expected string
+	}{
+		{name: "equal", before: "abc", after: "abc", expected: " a b c"},
+		{name: "insert", before: "ac", after: "abc", expected: " a+b c"},
+		{name: "delete", before: "abc", after: "ac", expected: " a-b c"},
+		{name: "replace", before: "abc", after: "axc", expected: " a-b+x c"},
+		{name: "from empty", before: "", after: "ab", expected: "+a+b"},
+		{name: "to empty", before: "ab", after: "", expected: "-a-b"},
+		{name: "myers example", before: "abcabba", after: "cbabac", expected: "-a+c b-c a b-b a+c"},
+		{name: "deletes before inserts", before: "aabaa", after: "babbbbbcba", expected: "+b a-a+b+b+b+b b-a+c+b a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script := EditScript(strings.Split(tt.before, ""), strings.Split(tt.after, ""), gvalue.Equal[string])
+			if got := renderEdits(script); got != tt.expected {
+				t.Errorf("EditScript() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	if script := EditScript[int](nil, nil, gvalue.Equal[int]); script != nil {
+		t.Errorf("EditScript() on nil slices = %v, want nil", script)
+	}
+}
+
+func TestEditScriptApply(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		before := randomInts(rng, rng.Intn(40))
+		after := randomInts(rng, rng.Intn(40))
+		script := EditScript(before, after, gvalue.Equal[int])
+
+		gotBefore, gotAfter := []int{}, []int{}
+		changes := 0
+		for j, e := range script {
+			if j > 0 && e.Op == EditDelete && script[j-1].Op == EditInsert {
+				t.Fatalf("EditScript(%v, %v) = %v has an insertion before a deletion", before, after, script)
+			}
+			switch e.Op {
+			case EditKeep:
+				if before[e.OldIndex] != e.Value || after[e.NewIndex] != e.Value {
+					t.Fatalf("EditScript() keep has wrong indices: %+v", e)
+				}
+				gotBefore = append(gotBefore, e.Value)
+				gotAfter = append(gotAfter, e.Value)
+			case EditDelete:
+				gotBefore = append(gotBefore, e.Value)
+				changes++
+			case EditInsert:
+				gotAfter = append(gotAfter, e.Value)
+				changes++
+			}
+		}
+		if !reflect.DeepEqual(gotBefore, before) || !reflect.DeepEqual(gotAfter, after) {
+			t.Fatalf("EditScript(%v, %v) = %v does not reproduce the inputs", before, after, script)
+		}
+		if want := len(before) + len(after) - 2*lcsLength(before, after); changes != want {
+			t.Fatalf("EditScript(%v, %v) has %d changes, want %d", before, after, changes, want)
+		}
+	}
+}
+
+func BenchmarkEditScriptDisjoint(b *testing.B) {
+	before := make([]int, 5000)
+	after := make([]int, 5000)
+	for i := range before {
+		before[i] = i
+		after[i] = -i - 1
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EditScript(before, after, gvalue.Equal[int])
+	}
+}
+
+func BenchmarkEditScriptSimilar(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	before := randomInts(rng, 20000)
+	after := append([]int(nil), before...)
+	for i := 0; i < 200; i++ {
+		after[rng.Intn(len(after))] = 4
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EditScript(before, after, gvalue.Equal[int])
+	}
+}
+
+func renderEdits[T any](script []Edit[T]) string {
+	var b strings.Builder
+	for _, e := range script {
+		s := e.String()
+		b.WriteString(s[:1])
+		b.WriteString(s[2:])
+	}
+	return b.String()
+}
+
+func randomInts(rng *rand.Rand, n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = rng.Intn(4)
+	}
+	return result
+}
+
+// lcsLength returns the length of the longest common subsequence of a and b.
+func lcsLength(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else if dp[i-1][j] > dp[i][j-1] {
+				dp[i][j] = dp[i-1][j]
+			} else {
+				dp[i][j] = dp[i][j-1]
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
@@ -18,11 +18,86 @@
 //   - [All]: checks if all elements satisfy a predicate
 //   - [Sort]: sorts a copy of the slice
 //   - [StealSort]: sorts the slice in place
+//   - [StealFilter], [StealUnique], [StealReverse], [StealMap], [StealCompact], [StealRemoveAt]:
+//     modify the slice in place and zero the unused tail
+//   - [SortStable], [SortBy], [SortByKeys]: stable sorts by less function, key or several keys
+//   - [IsSorted]: checks if a slice is sorted
+//   - [TopK], [BottomK]: select the k largest or smallest elements without a full sort
+//   - [BinarySearch], [BinarySearchBy], [LowerBound], [UpperBound]: search sorted slices
+//   - [InsertSorted], [MergeSorted]: insert into and merge sorted slices
+//   - [SortedUnion], [SortedIntersection], [SortedDifference]: set operations on sorted slices without maps
 //   - [Concat]: concatenates multiple slices
 //   - [Slice]: extracts a sub-slice
+//   - [SafeSlice]: extracts a sub-slice with Python-style index clamping
+//   - [InsertAt], [RemoveAt], [RemoveIf], [Move], [Swap], [Rotate], [Reverse]:
+//     return modified copies without panicking on out-of-range indices
+//   - [Fill], [Repeat]: build slices of repeated values
+//   - [IndexOf], [LastIndexOf], [IndexBy]: find the index of an element
+//   - [Chunk], [ChunkBy]: split a slice into batches by count or weight
+//   - [Window]: returns sliding windows of a slice
+//   - [Flatten]: concatenates nested slices
+//   - [Zip], [ZipWith], [Unzip]: combine and split parallel slices as [Pair] values
+//   - [Enumerate]: pairs elements with their index
+//   - [Product]: returns the cartesian product of two slices
+//   - [DiffBy]: compares records matched by key into added, removed, changed and unchanged
+//   - [EditScript]: returns the keep, insert and delete operations that turn one slice into another
+//   - [Shuffle], [Sample], [Choice], [WeightedChoice], [WeightedSample]: random
+//     selection with an injectable [Source] via [WithSource]
 //   - [CmpWith]: creates a comparison function with a fixed value
 //   - [Unique]: removes duplicate elements from a slice
+//   - [UniqueComparable], [UniqueBy]: remove duplicates in O(n) using hashing
+//   - [Duplicates], [DuplicatesBy]: report repeated elements and their indices
 //   - [Union]: merges multiple slices and removes duplicates
 //   - [Intersection]: returns elements that exist in all input slices
 //   - [Difference]: returns elements from the first slice not in other slices
+//   - [GroupBy]: groups elements by key
+//   - [KeyBy]: indexes elements by key with a [DuplicatePolicy]
+//   - [Partition]: splits elements by a predicate
+//   - [CountBy]: counts elements by key
+//   - [Associate]: builds a map from key-value pairs
+//   - [ToMap]: builds a map from key and value functions
+//
+// # Numeric Aggregates
+//
+// [Sum], [SumBy] and [ProductOf] detect integer overflow and return
+// [ErrOverflow]; float sums use compensated summation. [Mean], [Median],
+// [Percentile], [Variance], [StdDev], [Min], [Max], [MinMax], [MinBy] and
+// [MaxBy] return ok=false for an empty slice:
+//
+//	mean, ok := Mean([]int{1, 2, 3, 4})
+//	// mean is 2.5, ok is true
+//
+// # Fallible Operations
+//
+// [MapErr], [FilterErr], [ReduceErr], [FindErr] and [ForEachErr] accept
+// callbacks that return an error. They stop at the first error and return it
+// wrapped in an [IndexError] holding the index of the failing element.
+// [MapCollect] calls the callback for every element and returns all errors
+// as a [MultiError]:
+//
+//	numbers, err := MapErr([]string{"1", "2", "x"}, strconv.Atoi)
+//	// err is "index 2: strconv.Atoi: parsing "x": invalid syntax"
+//
+// # Parallel Operations
+//
+// [ParallelMap], [ParallelFilter], [ParallelForEach] and [ParallelReduce] run
+// an error-returning callback on a bounded number of goroutines while keeping
+// the order of the input. By default the first error cancels the remaining
+// work; use [WithErrorMode] with [CollectAll] to process every element and
+// receive a [MultiError]. Panics in callbacks are returned as [PanicError]:
+//
+//	users, err := ParallelMap(ctx, ids, 8, func(ctx context.Context, id int) (User, error) {
+//	    return client.GetUser(ctx, id)
+//	})
+//
+// # Sets
+//
+// [Set] is an unordered set of comparable elements and [OrderedSet] is a set
+// that remembers insertion order. Both support Add, Remove, Contains, Len,
+// Union, Intersect, Difference, SymmetricDifference, IsSubset, conversion to
+// and from slices, and JSON encoding as arrays:
+//
+//	s := NewOrderedSet(3, 1, 2)
+//	s.Add(1, 4)
+//	s.ToSlice() // []int{3, 1, 2, 4}
 package gslice
@@ -0,0 +1,244 @@
+package gslice
+
+// The functions in this file never panic on out-of-range indices and never
+// modify the input slice; each returns a new slice.
+
+// InsertAt returns a copy of the slice with values inserted before index i.
+//
+// An index below 0 inserts at the front and an index past the end appends.
+//
+// Example:
+//
+//	InsertAt([]int{1, 4}, 1, 2, 3) // []int{1, 2, 3, 4}
+func InsertAt[T any](s []T, i int, values ...T) []T {
+	i = clamp(i, 0, len(s))
+	result := make([]T, 0, len(s)+len(values))
+	result = append(result, s[:i]...)
+	result = append(result, values...)
+	return append(result, s[i:]...)
+}
+
+// RemoveAt returns a copy of the slice without the element at index i.
+//
+// If i is out of range, RemoveAt returns an unmodified copy. If the slice is
+// nil, RemoveAt returns nil.
+//
+// Example:
+//
+//	RemoveAt([]int{1, 2, 3}, 1) // []int{1, 3}
+func RemoveAt[T any](s []T, i int) []T {
+	return StealRemoveAt(clone(s), i)
+}
+
+// RemoveIf returns a new slice without the elements that satisfy the predicate.
+//
+// RemoveIf is the complement of [Filter].
+//
+// Example:
+//
+//	RemoveIf([]int{1, 2, 3, 4}, func(n int) bool { return n%2 == 0 }) // []int{1, 3}
+func RemoveIf[T any](s []T, fn func(T) bool) []T {
+	return Filter(s, func(v T) bool { return !fn(v) })
+}
+
+// Move returns a copy of the slice with the element at index from moved to
+// index to, shifting the elements in between.
+//
+// If from or to is out of range, Move returns an unmodified copy.
+//
+// Example:
+//
+//	Move([]string{"a", "b", "c", "d"}, 0, 2) // []string{"b", "c", "a", "d"}
+func Move[T any](s []T, from, to int) []T {
+	result := clone(s)
+	if !inRange(from, len(s)) || !inRange(to, len(s)) {
+		return result
+	}
+	v := result[from]
+	if from < to {
+		copy(result[from:to], result[from+1:to+1])
+	} else {
+		copy(result[to+1:from+1], result[to:from])
+	}
+	result[to] = v
+	return result
+}
+
+// Swap returns a copy of the slice with the elements at indices i and j swapped.
+//
+// If i or j is out of range, Swap returns an unmodified copy.
+//
+// Example:
+//
+//	Swap([]int{1, 2, 3}, 0, 2) // []int{3, 2, 1}
+func Swap[T any](s []T, i, j int) []T {
+	result := clone(s)
+	if inRange(i, len(s)) && inRange(j, len(s)) {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+// Rotate returns a copy of the slice rotated left by k positions. A negative
+// k rotates right.
+//
+// k may exceed the length of the slice.
+//
+// Example:
+//
+//	Rotate([]int{1, 2, 3, 4}, 1)  // []int{2, 3, 4, 1}
+//	Rotate([]int{1, 2, 3, 4}, -1) // []int{4, 1, 2, 3}
+func Rotate[T any](s []T, k int) []T {
+	if len(s) == 0 {
+		return clone(s)
+	}
+	k %= len(s)
+	if k < 0 {
+		k += len(s)
+	}
+	result := make([]T, 0, len(s))
+	result = append(result, s[k:]...)
+	return append(result, s[:k]...)
+}
+
+// Reverse returns a copy of the slice in reverse order.
+//
+// Reverse is the copying counterpart of [StealReverse].
+//
+// Example:
+//
+//	Reverse([]int{1, 2, 3}) // []int{3, 2, 1}
+func Reverse[T any](s []T) []T {
+	return StealReverse(clone(s))
+}
+
+// Fill returns a slice of n elements, each set to v.
+//
+// If n is not positive, Fill returns an empty slice.
+//
+// Example:
+//
+//	Fill(3, "x") // []string{"x", "x", "x"}
+func Fill[T any](n int, v T) []T {
+	if n < 0 {
+		n = 0
+	}
+	result := make([]T, n)
+	for i := range result {
+		result[i] = v
+	}
+	return result
+}
+
+// Repeat returns a new slice with the elements of s repeated n times.
+//
+// If n is not positive, Repeat returns an empty slice.
+//
+// Example:
+//
+//	Repeat([]int{1, 2}, 3) // []int{1, 2, 1, 2, 1, 2}
+func Repeat[T any](s []T, n int) []T {
+	if n < 0 {
+		n = 0
+	}
+	result := make([]T, 0, len(s)*n)
+	for i := 0; i < n; i++ {
+		result = append(result, s...)
+	}
+	return result
+}
+
+// IndexOf returns the index of the first occurrence of v in the slice, or -1
+// if v is not present.
+//
+// Example:
+//
+//	IndexOf([]string{"a", "b", "a"}, "a") // 0
+func IndexOf[T comparable](s []T, v T) int {
+	return IndexBy(s, func(e T) bool { return e == v })
+}
+
+// LastIndexOf returns the index of the last occurrence of v in the slice, or
+// -1 if v is not present.
+//
+// Example:
+//
+//	LastIndexOf([]string{"a", "b", "a"}, "a") // 2
+func LastIndexOf[T comparable](s []T, v T) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexBy returns the index of the first element that satisfies the
+// predicate, or -1 if there is none.
+//
+// Example:
+//
+//	IndexBy([]int{1, 4, 6}, func(n int) bool { return n%2 == 0 }) // 1
+func IndexBy[T any](s []T, fn func(T) bool) int {
+	for i, v := range s {
+		if fn(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SafeSlice returns a copy of the elements from index start (inclusive) to
+// index end (exclusive), clamping the indices like Python slicing.
+//
+// Negative indices count from the end of the slice, and indices beyond
+// either end are clamped to it. If start is not before end, SafeSlice returns
+// an empty slice. Unlike [Slice], SafeSlice never panics. If the slice is
+// nil, SafeSlice returns nil.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	SafeSlice(numbers, -2, 100) // []int{4, 5}
+//	SafeSlice(numbers, 1, -1)   // []int{2, 3, 4}
+func SafeSlice[T any](s []T, start, end int) []T {
+	if s == nil {
+		return nil
+	}
+	start = clamp(pythonIndex(start, len(s)), 0, len(s))
+	end = clamp(pythonIndex(end, len(s)), 0, len(s))
+	if start > end {
+		end = start
+	}
+	return Slice(s, start, end)
+}
+
+// clone returns a copy of s, preserving nil.
+func clone[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+func inRange(i, n int) bool {
+	return i >= 0 && i < n
+}
+
+func clamp(i, lo, hi int) int {
+	if i < lo {
+		return lo
+	}
+	if i > hi {
+		return hi
+	}
+	return i
+}
+
+// pythonIndex converts a negative index into an offset from the end.
+func pythonIndex(i, n int) int {
+	if i < 0 {
+		return i + n
+	}
+	return i
+}
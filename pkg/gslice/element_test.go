@@ -0,0 +1,206 @@
+package gslice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInsertAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		index    int
+		values   []int
+		expected []int
+	}{
+		{name: "middle", input: []int{1, 4}, index: 1, values: []int{2, 3}, expected: []int{1, 2, 3, 4}},
+		{name: "front", input: []int{2}, index: 0, values: []int{1}, expected: []int{1, 2}},
+		{name: "negative index", input: []int{2}, index: -5, values: []int{1}, expected: []int{1, 2}},
+		{name: "past end", input: []int{1}, index: 9, values: []int{2}, expected: []int{1, 2}},
+		{name: "nil slice", input: nil, index: 0, values: []int{1}, expected: []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := clone(tt.input)
+			result := InsertAt(tt.input, tt.index, tt.values...)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("InsertAt() = %v, want %v", result, tt.expected)
+			}
+			if !reflect.DeepEqual(tt.input, original) {
+				t.Errorf("InsertAt() modified the input slice: %v", tt.input)
+			}
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	if result := RemoveAt(input, 1); !reflect.DeepEqual(result, []int{1, 3, 4}) {
+		t.Errorf("RemoveAt() = %v, want [1 3 4]", result)
+	}
+	if result := RemoveAt(input, 4); !reflect.DeepEqual(result, input) {
+		t.Errorf("RemoveAt() out of range = %v, want %v", result, input)
+	}
+	if result := RemoveAt[int](nil, 0); result != nil {
+		t.Errorf("RemoveAt() on nil slice = %v, want nil", result)
+	}
+	if result := RemoveIf(input, func(n int) bool { return n%2 == 0 }); !reflect.DeepEqual(result, []int{1, 3}) {
+		t.Errorf("RemoveIf() = %v, want [1 3]", result)
+	}
+	if !reflect.DeepEqual(input, []int{1, 2, 3, 4}) {
+		t.Errorf("RemoveAt() modified the input slice: %v", input)
+	}
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     int
+		to       int
+		expected []string
+	}{
+		{name: "forward", from: 0, to: 2, expected: []string{"b", "c", "a", "d"}},
+		{name: "backward", from: 3, to: 1, expected: []string{"a", "d", "b", "c"}},
+		{name: "same index", from: 1, to: 1, expected: []string{"a", "b", "c", "d"}},
+		{name: "out of range", from: 0, to: 4, expected: []string{"a", "b", "c", "d"}},
+		{name: "negative", from: -1, to: 0, expected: []string{"a", "b", "c", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := []string{"a", "b", "c", "d"}
+			result := Move(input, tt.from, tt.to)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Move() = %v, want %v", result, tt.expected)
+			}
+			if input[0] != "a" || input[3] != "d" {
+				t.Errorf("Move() modified the input slice: %v", input)
+			}
+		})
+	}
+}
+
+func TestSwap(t *testing.T) {
+	input := []int{1, 2, 3}
+	if result := Swap(input, 0, 2); !reflect.DeepEqual(result, []int{3, 2, 1}) {
+		t.Errorf("Swap() = %v, want [3 2 1]", result)
+	}
+	if result := Swap(input, 0, 3); !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Swap() out of range = %v, want [1 2 3]", result)
+	}
+	if input[0] != 1 {
+		t.Errorf("Swap() modified the input slice: %v", input)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		k        int
+		expected []int
+	}{
+		{name: "left", input: []int{1, 2, 3, 4}, k: 1, expected: []int{2, 3, 4, 1}},
+		{name: "right", input: []int{1, 2, 3, 4}, k: -1, expected: []int{4, 1, 2, 3}},
+		{name: "more than length", input: []int{1, 2, 3}, k: 7, expected: []int{2, 3, 1}},
+		{name: "zero", input: []int{1, 2}, k: 0, expected: []int{1, 2}},
+		{name: "nil slice", input: nil, k: 3, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Rotate(tt.input, tt.k); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Rotate() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	input := []int{1, 2, 3}
+	if result := Reverse(input); !reflect.DeepEqual(result, []int{3, 2, 1}) {
+		t.Errorf("Reverse() = %v, want [3 2 1]", result)
+	}
+	if !reflect.DeepEqual(input, []int{1, 2, 3}) {
+		t.Errorf("Reverse() modified the input slice: %v", input)
+	}
+	if result := Reverse[int](nil); result != nil {
+		t.Errorf("Reverse() on nil slice = %v, want nil", result)
+	}
+}
+
+func TestFillRepeat(t *testing.T) {
+	if result := Fill(3, "x"); !reflect.DeepEqual(result, []string{"x", "x", "x"}) {
+		t.Errorf("Fill() = %v, want [x x x]", result)
+	}
+	if result := Fill(-1, "x"); !reflect.DeepEqual(result, []string{}) {
+		t.Errorf("Fill() with negative count = %v, want []", result)
+	}
+	if result := Repeat([]int{1, 2}, 3); !reflect.DeepEqual(result, []int{1, 2, 1, 2, 1, 2}) {
+		t.Errorf("Repeat() = %v, want [1 2 1 2 1 2]", result)
+	}
+	if result := Repeat([]int{1}, -2); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Repeat() with negative count = %v, want []", result)
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	input := []string{"a", "b", "a"}
+	tests := []struct {
+		name     string
+		result   int
+		expected int
+	}{
+		{name: "IndexOf", result: IndexOf(input, "a"), expected: 0},
+		{name: "IndexOf missing", result: IndexOf(input, "z"), expected: -1},
+		{name: "LastIndexOf", result: LastIndexOf(input, "a"), expected: 2},
+		{name: "LastIndexOf missing", result: LastIndexOf(input, "z"), expected: -1},
+		{name: "IndexBy", result: IndexBy(input, func(s string) bool { return s > "a" }), expected: 1},
+		{name: "IndexBy nil", result: IndexBy(nil, func(s string) bool { return true }), expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("%s = %v, want %v", tt.name, tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSafeSlice(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name     string
+		start    int
+		end      int
+		expected []int
+	}{
+		{name: "in range", start: 1, end: 4, expected: []int{2, 3, 4}},
+		{name: "negative start", start: -2, end: 5, expected: []int{4, 5}},
+		{name: "negative end", start: 1, end: -1, expected: []int{2, 3, 4}},
+		{name: "end past length", start: 3, end: 100, expected: []int{4, 5}},
+		{name: "start before beginning", start: -100, end: 2, expected: []int{1, 2}},
+		{name: "start after end", start: 4, end: 2, expected: []int{}},
+		{name: "start past length", start: 10, end: 20, expected: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := SafeSlice(input, tt.start, tt.end); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SafeSlice() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	result := SafeSlice(input, 0, 2)
+	result[0] = 100
+	if input[0] != 1 {
+		t.Errorf("SafeSlice() result shares memory with input")
+	}
+	if result := SafeSlice[int](nil, 0, 1); result != nil {
+		t.Errorf("SafeSlice() on nil slice = %v, want nil", result)
+	}
+}
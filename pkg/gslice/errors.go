@@ -0,0 +1,89 @@
+package gslice
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IndexError records the index of the element whose callback failed.
+//
+// Use errors.As to retrieve the index and errors.Is or errors.Unwrap to
+// inspect the underlying error.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError is returned when a callback panics. Value holds the value
+// passed to panic and Stack the stack trace of the panicking goroutine.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// MultiError holds several errors, ordered by the index of the element that
+// produced them.
+//
+// errors.Is and errors.As match any of the contained errors.
+type MultiError []error
+
+func (e MultiError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the contained errors.
+func (e MultiError) Unwrap() []error {
+	return e
+}
+
+// Is reports whether any of the contained errors matches target.
+func (e MultiError) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first contained error that matches target.
+func (e MultiError) As(target any) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinErrors returns nil if errs has no non-nil error, and a [MultiError]
+// with the non-nil errors otherwise.
+func joinErrors(errs []error) error {
+	var result MultiError
+	for _, err := range errs {
+		if err != nil {
+			result = append(result, err)
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package gslice
+
+import "fmt"
+
+// ErrDuplicateKey is returned by [KeyBy] with [ErrorOnDuplicate] when two
+// elements have the same key.
+var ErrDuplicateKey = fmt.Errorf("duplicate key")
+
+// DuplicatePolicy controls which element [KeyBy] keeps when several elements
+// have the same key.
+type DuplicatePolicy int
+
+const (
+	// KeepFirst keeps the first element with a given key.
+	KeepFirst DuplicatePolicy = iota
+	// KeepLast keeps the last element with a given key.
+	KeepLast
+	// ErrorOnDuplicate returns [ErrDuplicateKey] on the first repeated key.
+	ErrorOnDuplicate
+)
+
+// GroupBy groups the elements of the slice by the key returned by keyFn.
+//
+// Elements within a group keep their order in the input slice. The returned
+// map is never nil. The input slice is not modified.
+//
+// Example:
+//
+//	words := []string{"apple", "bob", "avocado", "cat"}
+//	groups := GroupBy(words, func(s string) byte { return s[0] })
+//	// groups is map[byte][]string{'a': {"apple", "avocado"}, 'b': {"bob"}, 'c': {"cat"}}
+func GroupBy[T any, K comparable](s []T, keyFn func(T) K) map[K][]T {
+	result := make(map[K][]T)
+	for _, v := range s {
+		key := keyFn(v)
+		result[key] = append(result[key], v)
+	}
+	return result
+}
+
+// KeyBy builds a map from the key returned by keyFn to the element.
+//
+// policy decides what happens when several elements have the same key. With
+// [ErrorOnDuplicate], KeyBy returns nil and an [IndexError] wrapping
+// [ErrDuplicateKey] for the first repeated element.
+//
+// Example:
+//
+//	users := []User{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}
+//	byID, err := KeyBy(users, func(u User) int { return u.ID }, ErrorOnDuplicate)
+//	// byID[2].Name is "b"
+func KeyBy[T any, K comparable](s []T, keyFn func(T) K, policy DuplicatePolicy) (map[K]T, error) {
+	result := make(map[K]T, len(s))
+	for i, v := range s {
+		key := keyFn(v)
+		if _, ok := result[key]; ok {
+			switch policy {
+			case KeepFirst:
+				continue
+			case ErrorOnDuplicate:
+				return nil, &IndexError{Index: i, Err: fmt.Errorf("`%v` %w", key, ErrDuplicateKey)}
+			}
+		}
+		result[key] = v
+	}
+	return result, nil
+}
+
+// Partition splits the slice into the elements that satisfy the predicate and
+// those that do not.
+//
+// Both slices keep the order of the input slice. The input slice is not
+// modified.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	evens, odds := Partition(numbers, func(n int) bool { return n%2 == 0 })
+//	// evens is []int{2, 4}, odds is []int{1, 3, 5}
+func Partition[T any](s []T, fn func(T) bool) ([]T, []T) {
+	if s == nil {
+		return nil, nil
+	}
+	yes := make([]T, 0, len(s))
+	no := make([]T, 0, len(s))
+	for _, v := range s {
+		if fn(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no
+}
+
+// CountBy counts the elements of the slice by the key returned by keyFn.
+//
+// The returned map is never nil.
+//
+// Example:
+//
+//	words := []string{"apple", "bob", "avocado"}
+//	counts := CountBy(words, func(s string) byte { return s[0] })
+//	// counts is map[byte]int{'a': 2, 'b': 1}
+func CountBy[T any, K comparable](s []T, keyFn func(T) K) map[K]int {
+	result := make(map[K]int)
+	for _, v := range s {
+		result[keyFn(v)]++
+	}
+	return result
+}
+
+// Associate builds a map from the key-value pairs returned by fn.
+//
+// If several elements produce the same key, the last one wins. The returned
+// map is never nil.
+//
+// Example:
+//
+//	users := []User{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}
+//	names := Associate(users, func(u User) (int, string) { return u.ID, u.Name })
+//	// names is map[int]string{1: "a", 2: "b"}
+func Associate[T any, K comparable, V any](s []T, fn func(T) (K, V)) map[K]V {
+	result := make(map[K]V, len(s))
+	for _, v := range s {
+		key, value := fn(v)
+		result[key] = value
+	}
+	return result
+}
+
+// ToMap builds a map from the keys returned by keyFn to the values returned
+// by valueFn.
+//
+// If several elements have the same key, the last one wins. The returned map
+// is never nil.
+//
+// Example:
+//
+//	users := []User{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}
+//	names := ToMap(users, func(u User) int { return u.ID }, func(u User) string { return u.Name })
+//	// names is map[int]string{1: "a", 2: "b"}
+func ToMap[T any, K comparable, V any](s []T, keyFn func(T) K, valueFn func(T) V) map[K]V {
+	result := make(map[K]V, len(s))
+	for _, v := range s {
+		result[keyFn(v)] = valueFn(v)
+	}
+	return result
+}
@@ -0,0 +1,159 @@
+package gslice
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type groupItem struct {
+	ID   int
+	Name string
+}
+
+func firstByte(s string) byte { return s[0] }
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected map[byte][]string
+	}{
+		{
+			name:  "keeps order within groups",
+			input: []string{"apple", "bob", "avocado", "cat", "banana"},
+			expected: map[byte][]string{
+				'a': {"apple", "avocado"},
+				'b': {"bob", "banana"},
+				'c': {"cat"},
+			},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			expected: map[byte][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := GroupBy(tt.input, firstByte)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("GroupBy() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	items := []groupItem{{1, "a"}, {2, "b"}, {1, "c"}}
+	byID := func(item groupItem) int { return item.ID }
+
+	tests := []struct {
+		name     string
+		policy   DuplicatePolicy
+		expected map[int]groupItem
+		wantErr  bool
+	}{
+		{
+			name:     "keep first",
+			policy:   KeepFirst,
+			expected: map[int]groupItem{1: {1, "a"}, 2: {2, "b"}},
+		},
+		{
+			name:     "keep last",
+			policy:   KeepLast,
+			expected: map[int]groupItem{1: {1, "c"}, 2: {2, "b"}},
+		},
+		{
+			name:     "error on duplicate",
+			policy:   ErrorOnDuplicate,
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := KeyBy(items, byID, tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("KeyBy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("KeyBy() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	_, err := KeyBy(items, byID, ErrorOnDuplicate)
+	if !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("KeyBy() error = %v, want %v", err, ErrDuplicateKey)
+	}
+	checkIndexError(t, "KeyBy()", err, 2)
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []int
+		wantYes []int
+		wantNo  []int
+	}{
+		{
+			name:    "mixed",
+			input:   []int{1, 2, 3, 4, 5},
+			wantYes: []int{2, 4},
+			wantNo:  []int{1, 3, 5},
+		},
+		{
+			name:    "all match",
+			input:   []int{2, 4},
+			wantYes: []int{2, 4},
+			wantNo:  []int{},
+		},
+		{
+			name:    "nil slice",
+			input:   nil,
+			wantYes: nil,
+			wantNo:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			yes, no := Partition(tt.input, func(n int) bool { return n%2 == 0 })
+			if !reflect.DeepEqual(yes, tt.wantYes) || !reflect.DeepEqual(no, tt.wantNo) {
+				t.Errorf("Partition() = %v, %v, want %v, %v", yes, no, tt.wantYes, tt.wantNo)
+			}
+		})
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	result := CountBy([]string{"apple", "bob", "avocado"}, firstByte)
+	expected := map[byte]int{'a': 2, 'b': 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("CountBy() = %v, want %v", result, expected)
+	}
+}
+
+func TestAssociate(t *testing.T) {
+	items := []groupItem{{1, "a"}, {2, "b"}, {1, "c"}}
+	result := Associate(items, func(item groupItem) (int, string) { return item.ID, item.Name })
+	expected := map[int]string{1: "c", 2: "b"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Associate() = %v, want %v", result, expected)
+	}
+}
+
+func TestToMap(t *testing.T) {
+	items := []groupItem{{1, "a"}, {2, "b"}}
+	result := ToMap(items, func(item groupItem) string { return item.Name }, func(item groupItem) int { return item.ID })
+	expected := map[string]int{"a": 1, "b": 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ToMap() = %v, want %v", result, expected)
+	}
+	if result := ToMap(nil, func(item groupItem) int { return item.ID }, func(item groupItem) int { return item.ID }); result == nil || len(result) != 0 {
+		t.Errorf("ToMap() on nil slice = %v, want empty map", result)
+	}
+}
@@ -0,0 +1,184 @@
+package gslice
+
+import "github.com/geebos/gocraft/pkg/gjson"
+
+// Set is an unordered collection of unique comparable elements.
+//
+// The zero value is an empty set ready to use. Read-only methods accept a nil
+// *Set and treat it as empty. Set is not safe for concurrent use.
+//
+// Iteration order of [Set.ToSlice], [Set.Range] and the JSON encoding is
+// unspecified. Use [OrderedSet] when a deterministic order is required.
+//
+// Example:
+//
+//	s := NewSet(1, 2, 3)
+//	s.Add(4)
+//	s.Contains(2) // true
+//	s.Union(NewSet(5)).Len() // 5
+type Set[T comparable] struct {
+	m map[T]struct{}
+}
+
+// NewSet creates a set containing the given items.
+//
+// To create a set from a slice, use NewSet(s...).
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{m: make(map[T]struct{}, len(items))}
+	s.Add(items...)
+	return s
+}
+
+func (s *Set[T]) items() map[T]struct{} {
+	if s == nil {
+		return nil
+	}
+	return s.m
+}
+
+// Add adds the items to the set.
+func (s *Set[T]) Add(items ...T) {
+	if s.m == nil {
+		s.m = make(map[T]struct{}, len(items))
+	}
+	for _, item := range items {
+		s.m[item] = struct{}{}
+	}
+}
+
+// Remove removes the items from the set. Items not in the set are ignored.
+func (s *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s.m, item)
+	}
+}
+
+// Contains reports whether item is in the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items()[item]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items())
+}
+
+// Clear removes all elements from the set.
+func (s *Set[T]) Clear() {
+	s.m = make(map[T]struct{})
+}
+
+// Clone returns a copy of the set.
+func (s *Set[T]) Clone() *Set[T] {
+	result := &Set[T]{m: make(map[T]struct{}, s.Len())}
+	for item := range s.items() {
+		result.m[item] = struct{}{}
+	}
+	return result
+}
+
+// ToSlice returns the elements of the set in unspecified order.
+func (s *Set[T]) ToSlice() []T {
+	result := make([]T, 0, s.Len())
+	for item := range s.items() {
+		result = append(result, item)
+	}
+	return result
+}
+
+// Range calls fn for each element of the set in unspecified order.
+// If fn returns false, Range stops the iteration.
+func (s *Set[T]) Range(fn func(item T) bool) {
+	for item := range s.items() {
+		if !fn(item) {
+			return
+		}
+	}
+}
+
+// Union returns a new set with the elements of both s and other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := s.Clone()
+	for item := range other.items() {
+		result.m[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersect returns a new set with the elements that are in both s and other.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	result := &Set[T]{m: make(map[T]struct{})}
+	for item := range small.items() {
+		if large.Contains(item) {
+			result.m[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the elements of s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := &Set[T]{m: make(map[T]struct{})}
+	for item := range s.items() {
+		if !other.Contains(item) {
+			result.m[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set with the elements that are in
+// exactly one of s and other.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	result := s.Difference(other)
+	for item := range other.items() {
+		if !s.Contains(item) {
+			result.m[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset reports whether every element of s is in other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for item := range s.items() {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether every element of other is in s.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether s and other contain the same elements.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// MarshalJSON encodes the set as a JSON array in unspecified order.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return gjson.Marshal[[]byte](s.ToSlice())
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its contents.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	items, err := gjson.Unmarshal[[]T](data)
+	if err != nil {
+		return err
+	}
+	s.Clear()
+	s.Add(items...)
+	return nil
+}
@@ -0,0 +1,153 @@
+package gslice
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSetBasic(t *testing.T) {
+	var s Set[int]
+	if s.Len() != 0 || s.Contains(1) {
+		t.Errorf("zero Set should be empty")
+	}
+
+	s.Add(1, 2, 2, 3)
+	if s.Len() != 3 {
+		t.Errorf("Len() = %v, want 3", s.Len())
+	}
+	if !s.Contains(2) || s.Contains(4) {
+		t.Errorf("Contains() returned unexpected result")
+	}
+
+	s.Remove(2, 4)
+	if s.Len() != 2 || s.Contains(2) {
+		t.Errorf("Remove() did not remove element, got %v", s.ToSlice())
+	}
+
+	clone := s.Clone()
+	clone.Add(5)
+	if s.Contains(5) {
+		t.Errorf("Clone() shares storage with original")
+	}
+
+	s.Clear()
+	if s.Len() != 0 {
+		t.Errorf("Clear() left %v elements", s.Len())
+	}
+
+	var nilSet *Set[int]
+	if nilSet.Len() != 0 || nilSet.Contains(1) || len(nilSet.ToSlice()) != 0 {
+		t.Errorf("nil *Set should behave as empty")
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(a, b *Set[int]) *Set[int]
+		a, b     []int
+		expected []int
+	}{
+		{
+			name:     "union",
+			op:       (*Set[int]).Union,
+			a:        []int{1, 2, 3},
+			b:        []int{3, 4},
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:     "intersect",
+			op:       (*Set[int]).Intersect,
+			a:        []int{1, 2, 3},
+			b:        []int{2, 3, 4},
+			expected: []int{2, 3},
+		},
+		{
+			name:     "difference",
+			op:       (*Set[int]).Difference,
+			a:        []int{1, 2, 3},
+			b:        []int{2, 4},
+			expected: []int{1, 3},
+		},
+		{
+			name:     "symmetric difference",
+			op:       (*Set[int]).SymmetricDifference,
+			a:        []int{1, 2, 3},
+			b:        []int{2, 3, 4},
+			expected: []int{1, 4},
+		},
+		{
+			name:     "empty",
+			op:       (*Set[int]).Intersect,
+			a:        []int{1},
+			b:        nil,
+			expected: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.op(NewSet(tt.a...), NewSet(tt.b...))
+			got := Sort(result.ToSlice(), func(a, b int) bool { return a < b })
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetRelations(t *testing.T) {
+	a := NewSet(1, 2)
+	b := NewSet(1, 2, 3)
+	if !a.IsSubset(b) || b.IsSubset(a) {
+		t.Errorf("IsSubset() returned unexpected result")
+	}
+	if !b.IsSuperset(a) || a.IsSuperset(b) {
+		t.Errorf("IsSuperset() returned unexpected result")
+	}
+	if !a.Equal(NewSet(2, 1)) || a.Equal(b) {
+		t.Errorf("Equal() returned unexpected result")
+	}
+	if !NewSet[int]().IsSubset(nil) {
+		t.Errorf("empty set should be a subset of nil set")
+	}
+}
+
+func TestSetRange(t *testing.T) {
+	s := NewSet(1, 2, 3)
+	count := 0
+	s.Range(func(int) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Errorf("Range() did not stop, visited %v elements", count)
+	}
+}
+
+func TestSetJSON(t *testing.T) {
+	type payload struct {
+		Tags Set[string] `json:"tags"`
+	}
+
+	var p payload
+	if err := json.Unmarshal([]byte(`{"tags":["a","b","a"]}`), &p); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !p.Tags.Equal(NewSet("a", "b")) {
+		t.Errorf("Unmarshal() = %v, want [a b]", p.Tags.ToSlice())
+	}
+
+	data, err := json.Marshal(payload{Tags: *NewSet("x")})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != `{"tags":["x"]}` {
+		t.Errorf("Marshal() = %s, want {\"tags\":[\"x\"]}", data)
+	}
+
+	if err := p.Tags.UnmarshalJSON([]byte(`{}`)); err == nil {
+		t.Errorf("UnmarshalJSON() expected error for non-array input")
+	}
+}
@@ -0,0 +1,207 @@
+package gslice
+
+import "github.com/geebos/gocraft/pkg/gjson"
+
+// OrderedSet is a collection of unique comparable elements that remembers
+// insertion order.
+//
+// Adding an element that is already present keeps its original position.
+// Set operations return elements of the receiver first, in its order,
+// followed by elements of the argument. The zero value is an empty set ready
+// to use. OrderedSet is not safe for concurrent use.
+//
+// Example:
+//
+//	s := NewOrderedSet(3, 1, 2, 1)
+//	s.ToSlice() // []int{3, 1, 2}
+//	s.Intersect(NewOrderedSet(2, 3)).ToSlice() // []int{3, 2}
+type OrderedSet[T comparable] struct {
+	items []T
+	index map[T]int
+}
+
+// NewOrderedSet creates an ordered set containing the given items in order.
+//
+// To create a set from a slice, use NewOrderedSet(s...).
+func NewOrderedSet[T comparable](items ...T) *OrderedSet[T] {
+	s := &OrderedSet[T]{
+		items: make([]T, 0, len(items)),
+		index: make(map[T]int, len(items)),
+	}
+	s.Add(items...)
+	return s
+}
+
+func (s *OrderedSet[T]) elems() []T {
+	if s == nil {
+		return nil
+	}
+	return s.items
+}
+
+// Add appends the items that are not yet in the set.
+func (s *OrderedSet[T]) Add(items ...T) {
+	if s.index == nil {
+		s.index = make(map[T]int, len(items))
+	}
+	for _, item := range items {
+		if _, ok := s.index[item]; ok {
+			continue
+		}
+		s.index[item] = len(s.items)
+		s.items = append(s.items, item)
+	}
+}
+
+// Remove removes the items from the set. Items not in the set are ignored.
+//
+// Remove keeps the order of the remaining elements and runs in O(n).
+func (s *OrderedSet[T]) Remove(items ...T) {
+	removed := false
+	for _, item := range items {
+		if _, ok := s.index[item]; ok {
+			delete(s.index, item)
+			removed = true
+		}
+	}
+	if !removed {
+		return
+	}
+
+	kept := s.items[:0]
+	for _, item := range s.items {
+		if _, ok := s.index[item]; ok {
+			s.index[item] = len(kept)
+			kept = append(kept, item)
+		}
+	}
+	var zero T
+	for i := len(kept); i < len(s.items); i++ {
+		s.items[i] = zero
+	}
+	s.items = kept
+}
+
+// Contains reports whether item is in the set.
+func (s *OrderedSet[T]) Contains(item T) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.index[item]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s *OrderedSet[T]) Len() int {
+	return len(s.elems())
+}
+
+// Clear removes all elements from the set.
+func (s *OrderedSet[T]) Clear() {
+	s.items = nil
+	s.index = make(map[T]int)
+}
+
+// Clone returns a copy of the set.
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	return NewOrderedSet(s.elems()...)
+}
+
+// ToSlice returns the elements of the set in insertion order.
+func (s *OrderedSet[T]) ToSlice() []T {
+	result := make([]T, s.Len())
+	copy(result, s.elems())
+	return result
+}
+
+// Range calls fn for each element of the set in insertion order.
+// If fn returns false, Range stops the iteration.
+func (s *OrderedSet[T]) Range(fn func(item T) bool) {
+	for _, item := range s.elems() {
+		if !fn(item) {
+			return
+		}
+	}
+}
+
+// Union returns a new set with the elements of s followed by the elements
+// of other that are not in s.
+func (s *OrderedSet[T]) Union(other *OrderedSet[T]) *OrderedSet[T] {
+	result := s.Clone()
+	result.Add(other.elems()...)
+	return result
+}
+
+// Intersect returns a new set with the elements of s that are also in other,
+// in the order of s.
+func (s *OrderedSet[T]) Intersect(other *OrderedSet[T]) *OrderedSet[T] {
+	return s.filter(other.Contains)
+}
+
+// Difference returns a new set with the elements of s that are not in other,
+// in the order of s.
+func (s *OrderedSet[T]) Difference(other *OrderedSet[T]) *OrderedSet[T] {
+	return s.filter(func(item T) bool { return !other.Contains(item) })
+}
+
+// SymmetricDifference returns a new set with the elements of s that are not
+// in other, followed by the elements of other that are not in s.
+func (s *OrderedSet[T]) SymmetricDifference(other *OrderedSet[T]) *OrderedSet[T] {
+	result := s.Difference(other)
+	for _, item := range other.elems() {
+		if !s.Contains(item) {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// IsSubset reports whether every element of s is in other.
+func (s *OrderedSet[T]) IsSubset(other *OrderedSet[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for _, item := range s.elems() {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether every element of other is in s.
+func (s *OrderedSet[T]) IsSuperset(other *OrderedSet[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether s and other contain the same elements, regardless of order.
+func (s *OrderedSet[T]) Equal(other *OrderedSet[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// MarshalJSON encodes the set as a JSON array in insertion order.
+func (s OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	return gjson.Marshal[[]byte](s.ToSlice())
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its contents.
+// Duplicate elements keep their first position.
+func (s *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	items, err := gjson.Unmarshal[[]T](data)
+	if err != nil {
+		return err
+	}
+	s.Clear()
+	s.Add(items...)
+	return nil
+}
+
+func (s *OrderedSet[T]) filter(keep func(T) bool) *OrderedSet[T] {
+	result := NewOrderedSet[T]()
+	for _, item := range s.elems() {
+		if keep(item) {
+			result.Add(item)
+		}
+	}
+	return result
+}
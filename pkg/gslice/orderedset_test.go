@@ -0,0 +1,115 @@
+package gslice
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedSetBasic(t *testing.T) {
+	var s OrderedSet[string]
+	s.Add("c", "a", "b", "a")
+	if got := s.ToSlice(); !reflect.DeepEqual(got, []string{"c", "a", "b"}) {
+		t.Errorf("Add() = %v, want [c a b]", got)
+	}
+
+	s.Remove("a", "x")
+	if got := s.ToSlice(); !reflect.DeepEqual(got, []string{"c", "b"}) {
+		t.Errorf("Remove() = %v, want [c b]", got)
+	}
+	if s.Contains("a") || !s.Contains("b") || s.Len() != 2 {
+		t.Errorf("Contains()/Len() returned unexpected result")
+	}
+
+	s.Add("a")
+	if got := s.ToSlice(); !reflect.DeepEqual(got, []string{"c", "b", "a"}) {
+		t.Errorf("Add() after Remove() = %v, want [c b a]", got)
+	}
+
+	s.Clear()
+	if s.Len() != 0 || s.Contains("c") {
+		t.Errorf("Clear() did not empty the set")
+	}
+
+	var nilSet *OrderedSet[int]
+	if nilSet.Len() != 0 || nilSet.Contains(1) || len(nilSet.ToSlice()) != 0 {
+		t.Errorf("nil *OrderedSet should behave as empty")
+	}
+}
+
+func TestOrderedSetOperations(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(a, b *OrderedSet[int]) *OrderedSet[int]
+		a, b     []int
+		expected []int
+	}{
+		{
+			name:     "union",
+			op:       (*OrderedSet[int]).Union,
+			a:        []int{3, 1, 2},
+			b:        []int{5, 2, 4},
+			expected: []int{3, 1, 2, 5, 4},
+		},
+		{
+			name:     "intersect",
+			op:       (*OrderedSet[int]).Intersect,
+			a:        []int{4, 3, 2, 1},
+			b:        []int{1, 2, 3},
+			expected: []int{3, 2, 1},
+		},
+		{
+			name:     "difference",
+			op:       (*OrderedSet[int]).Difference,
+			a:        []int{5, 1, 4, 2},
+			b:        []int{4},
+			expected: []int{5, 1, 2},
+		},
+		{
+			name:     "symmetric difference",
+			op:       (*OrderedSet[int]).SymmetricDifference,
+			a:        []int{3, 2, 1},
+			b:        []int{6, 2, 5},
+			expected: []int{3, 1, 6, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.op(NewOrderedSet(tt.a...), NewOrderedSet(tt.b...))
+			if got := result.ToSlice(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOrderedSetRelations(t *testing.T) {
+	a := NewOrderedSet(2, 1)
+	b := NewOrderedSet(1, 2, 3)
+	if !a.IsSubset(b) || b.IsSubset(a) || !b.IsSuperset(a) {
+		t.Errorf("IsSubset()/IsSuperset() returned unexpected result")
+	}
+	if !a.Equal(NewOrderedSet(1, 2)) || a.Equal(b) {
+		t.Errorf("Equal() returned unexpected result")
+	}
+}
+
+func TestOrderedSetJSON(t *testing.T) {
+	s := NewOrderedSet(3, 1, 2)
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != `[3,1,2]` {
+		t.Errorf("Marshal() = %s, want [3,1,2]", data)
+	}
+
+	var decoded OrderedSet[int]
+	if err := json.Unmarshal([]byte(`[2,2,1]`), &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := decoded.ToSlice(); !reflect.DeepEqual(got, []int{2, 1}) {
+		t.Errorf("Unmarshal() = %v, want [2 1]", got)
+	}
+}
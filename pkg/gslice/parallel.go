@@ -0,0 +1,315 @@
+package gslice
+
+import (
+	"context"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrorMode controls how parallel operations react to callback errors.
+type ErrorMode int
+
+const (
+	// FailFast cancels the remaining work on the first error and returns it.
+	FailFast ErrorMode = iota
+	// CollectAll processes every element and returns all errors as a [MultiError].
+	CollectAll
+)
+
+// ParallelOption configures a parallel operation.
+type ParallelOption func(*parallelConfig)
+
+type parallelConfig struct {
+	mode ErrorMode
+}
+
+// WithErrorMode sets how errors are handled. The default is [FailFast].
+//
+// Example:
+//
+//	results, err := ParallelMap(ctx, ids, 8, fetch, WithErrorMode(CollectAll))
+func WithErrorMode(mode ErrorMode) ParallelOption {
+	return func(c *parallelConfig) {
+		c.mode = mode
+	}
+}
+
+// ParallelMap transforms each element of the input slice using up to workers
+// goroutines.
+//
+// The results keep the order of the input slice. If workers is not positive,
+// runtime.GOMAXPROCS(0) is used. fn receives a context that is canceled when
+// ctx is canceled or, in [FailFast] mode, when another call fails.
+//
+// Errors are wrapped in an [IndexError] and panics are recovered as a
+// [PanicError]. In [FailFast] mode ParallelMap returns a nil slice and the
+// first error. In [CollectAll] mode it returns the results, with zero values
+// for failed elements, and a [MultiError] of all errors. If ctx is canceled
+// before all elements are processed, its error is returned.
+//
+// Example:
+//
+//	users, err := ParallelMap(ctx, ids, 8, func(ctx context.Context, id int) (User, error) {
+//	    return client.GetUser(ctx, id)
+//	})
+func ParallelMap[T, R any](ctx context.Context, s []T, workers int, fn func(context.Context, T) (R, error), opts ...ParallelOption) ([]R, error) {
+	if s == nil {
+		return nil, nil
+	}
+	result := make([]R, len(s))
+	r := newParallelRunner(ctx, len(s), opts)
+	r.run(len(s), workers, func(i int) {
+		r.call(i, func(ctx context.Context) error {
+			v, err := fn(ctx, s[i])
+			if err == nil {
+				result[i] = v
+			}
+			return err
+		})
+	})
+	if err := r.err(); err != nil {
+		if r.mode == FailFast {
+			return nil, err
+		}
+		return result, err
+	}
+	return result, nil
+}
+
+// ParallelFilter returns the elements that satisfy the predicate, evaluating
+// it with up to workers goroutines.
+//
+// The result keeps the order of the input slice. Workers, cancellation and
+// errors are handled as in [ParallelMap]; in [CollectAll] mode elements whose
+// predicate failed are left out of the result.
+//
+// Example:
+//
+//	active, err := ParallelFilter(ctx, users, 4, func(ctx context.Context, u User) (bool, error) {
+//	    return client.IsActive(ctx, u.ID)
+//	})
+func ParallelFilter[T any](ctx context.Context, s []T, workers int, fn func(context.Context, T) (bool, error), opts ...ParallelOption) ([]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	keep := make([]bool, len(s))
+	r := newParallelRunner(ctx, len(s), opts)
+	r.run(len(s), workers, func(i int) {
+		r.call(i, func(ctx context.Context) error {
+			ok, err := fn(ctx, s[i])
+			keep[i] = ok && err == nil
+			return err
+		})
+	})
+	err := r.err()
+	if err != nil && r.mode == FailFast {
+		return nil, err
+	}
+	result := make([]T, 0, len(s))
+	for i, v := range s {
+		if keep[i] {
+			result = append(result, v)
+		}
+	}
+	return result, err
+}
+
+// ParallelForEach calls fn for each element of the slice using up to workers
+// goroutines.
+//
+// Workers, cancellation and errors are handled as in [ParallelMap].
+//
+// Example:
+//
+//	err := ParallelForEach(ctx, files, 4, func(ctx context.Context, f string) error {
+//	    return upload(ctx, f)
+//	}, WithErrorMode(CollectAll))
+func ParallelForEach[T any](ctx context.Context, s []T, workers int, fn func(context.Context, T) error, opts ...ParallelOption) error {
+	r := newParallelRunner(ctx, len(s), opts)
+	r.run(len(s), workers, func(i int) {
+		r.call(i, func(ctx context.Context) error {
+			return fn(ctx, s[i])
+		})
+	})
+	return r.err()
+}
+
+// ParallelReduce reduces the slice to a single value using up to workers
+// goroutines.
+//
+// The slice is split into contiguous chunks, one per worker. Each chunk is
+// reduced in order with fn starting from initial, and the partial results are
+// combined in chunk order with merge. initial must therefore be an identity
+// of merge, and merge must be associative.
+//
+// Workers, cancellation and errors are handled as in [ParallelMap]. In
+// [FailFast] mode the zero value is returned on error. In [CollectAll] mode
+// failed elements are skipped and the reduced value of the remaining
+// elements is returned along with the errors.
+//
+// Example:
+//
+//	total, err := ParallelReduce(ctx, orders, 4, 0,
+//	    func(ctx context.Context, acc int, o Order) (int, error) {
+//	        price, err := pricing.Get(ctx, o)
+//	        return acc + price, err
+//	    },
+//	    func(a, b int) int { return a + b },
+//	)
+func ParallelReduce[T, R any](ctx context.Context, s []T, workers int, initial R, fn func(context.Context, R, T) (R, error), merge func(R, R) R, opts ...ParallelOption) (R, error) {
+	workers = parallelWorkers(workers, len(s))
+	if workers == 0 {
+		return initial, nil
+	}
+
+	size := (len(s) + workers - 1) / workers
+	chunks := (len(s) + size - 1) / size
+	partials := make([]R, chunks)
+	r := newParallelRunner(ctx, len(s), opts)
+	r.run(chunks, workers, func(c int) {
+		acc := initial
+		for i := c * size; i < len(s) && i < (c+1)*size; i++ {
+			if r.ctx.Err() != nil {
+				r.skip()
+				break
+			}
+			r.call(i, func(ctx context.Context) error {
+				v, err := fn(ctx, acc, s[i])
+				if err == nil {
+					acc = v
+				}
+				return err
+			})
+		}
+		partials[c] = acc
+	})
+
+	err := r.err()
+	if err != nil && r.mode == FailFast {
+		var zero R
+		return zero, err
+	}
+	result := partials[0]
+	for _, partial := range partials[1:] {
+		result = merge(result, partial)
+	}
+	return result, err
+}
+
+// parallelWorkers returns the number of goroutines to use for n tasks.
+func parallelWorkers(workers, n int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	return workers
+}
+
+// parallelRunner tracks cancellation and errors of a parallel operation.
+type parallelRunner struct {
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelFunc
+	mode   ErrorMode
+	errs   []error
+	once   sync.Once
+	first  error
+
+	mu sync.Mutex
+	// canceled is the parent context error observed when work was skipped.
+	canceled error
+}
+
+func newParallelRunner(ctx context.Context, n int, opts []ParallelOption) *parallelRunner {
+	var cfg parallelConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	r := &parallelRunner{parent: ctx, mode: cfg.mode, errs: make([]error, n)}
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	return r
+}
+
+// run calls task for every task index on up to workers goroutines and waits
+// for them to finish. Tasks that have not started when the context is
+// canceled are skipped.
+func (r *parallelRunner) run(tasks, workers int, task func(t int)) {
+	defer r.cancel()
+	workers = parallelWorkers(workers, tasks)
+	next := int64(-1)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				t := int(atomic.AddInt64(&next, 1))
+				if t >= tasks {
+					return
+				}
+				if r.ctx.Err() != nil {
+					r.skip()
+					return
+				}
+				task(t)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// call calls fn for element i and records its error or panic.
+func (r *parallelRunner) call(i int, fn func(ctx context.Context) error) {
+	err := recoverCall(r.ctx, fn)
+	if err == nil {
+		return
+	}
+	err = &IndexError{Index: i, Err: err}
+	r.errs[i] = err
+	if r.mode == FailFast {
+		r.once.Do(func() {
+			r.first = err
+			r.cancel()
+		})
+	}
+}
+
+// skip records that work was skipped because the context was canceled.
+func (r *parallelRunner) skip() {
+	err := r.parent.Err()
+	if err == nil {
+		return
+	}
+	r.mu.Lock()
+	if r.canceled == nil {
+		r.canceled = err
+	}
+	r.mu.Unlock()
+}
+
+// err returns the error of the operation. It must be called after run.
+//
+// The parent context error is only returned if work was skipped because of
+// it, so a cancellation after all work completed does not fail the operation.
+func (r *parallelRunner) err() error {
+	if r.first != nil {
+		return r.first
+	}
+	if r.mode == FailFast {
+		return r.canceled
+	}
+	return joinErrors(append(r.errs, r.canceled))
+}
+
+func recoverCall(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = &PanicError{Value: v, Stack: debug.Stack()}
+		}
+	}()
+	return fn(ctx)
+}
@@ -0,0 +1,241 @@
+package gslice
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errOdd = errors.New("odd")
+
+func failOdd(_ context.Context, n int) (int, error) {
+	if n%2 != 0 {
+		return 0, errOdd
+	}
+	return n * 10, nil
+}
+
+func TestParallelMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		workers  int
+		fn       func(context.Context, int) (int, error)
+		opts     []ParallelOption
+		expected []int
+		wantErr  bool
+	}{
+		{
+			name:    "keeps order",
+			input:   []int{5, 4, 3, 2, 1},
+			workers: 3,
+			fn: func(_ context.Context, n int) (int, error) {
+				time.Sleep(time.Duration(n) * time.Millisecond)
+				return n * 2, nil
+			},
+			expected: []int{10, 8, 6, 4, 2},
+		},
+		{
+			name:     "default workers",
+			input:    []int{1, 2, 3},
+			workers:  0,
+			fn:       func(_ context.Context, n int) (int, error) { return n + 1, nil },
+			expected: []int{2, 3, 4},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			workers:  2,
+			fn:       failOdd,
+			expected: nil,
+		},
+		{
+			name:     "empty slice",
+			input:    []int{},
+			workers:  2,
+			fn:       failOdd,
+			expected: []int{},
+		},
+		{
+			name:     "fail fast",
+			input:    []int{2, 3, 4},
+			workers:  1,
+			fn:       failOdd,
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "collect all",
+			input:    []int{2, 3, 4},
+			workers:  2,
+			fn:       failOdd,
+			opts:     []ParallelOption{WithErrorMode(CollectAll)},
+			expected: []int{20, 0, 40},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParallelMap(context.Background(), tt.input, tt.workers, tt.fn, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParallelMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParallelMap() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParallelErrors(t *testing.T) {
+	_, err := ParallelMap(context.Background(), []int{1, 2, 3, 5}, 2, failOdd, WithErrorMode(CollectAll))
+	var multi MultiError
+	if !errors.As(err, &multi) || len(multi) != 3 {
+		t.Fatalf("ParallelMap() error = %v, want 3 errors", err)
+	}
+	var indexErr *IndexError
+	if !errors.As(multi[1], &indexErr) || indexErr.Index != 2 || !errors.Is(err, errOdd) {
+		t.Errorf("ParallelMap() errors = %v, want ordered index errors", err)
+	}
+
+	_, err = ParallelMap(context.Background(), []int{2, 3}, 1, failOdd)
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 || !errors.Is(err, errOdd) {
+		t.Errorf("ParallelMap() error = %v, want index 1 error", err)
+	}
+
+	err = ParallelForEach(context.Background(), []int{1, 2}, 2, func(_ context.Context, n int) error {
+		if n == 2 {
+			panic("boom")
+		}
+		return nil
+	})
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Value != "boom" || len(panicErr.Stack) == 0 {
+		t.Errorf("ParallelForEach() error = %v, want recovered panic", err)
+	}
+}
+
+func TestParallelFailFastCancels(t *testing.T) {
+	var calls int64
+	input := make([]int, 100)
+	err := ParallelForEach(context.Background(), input, 2, func(ctx context.Context, n int) error {
+		if atomic.AddInt64(&calls, 1) == 1 {
+			return errOdd
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if !errors.Is(err, errOdd) {
+		t.Errorf("ParallelForEach() error = %v, want %v", err, errOdd)
+	}
+	if calls > 3 {
+		t.Errorf("ParallelForEach() made %v calls after failure, want at most 3", calls)
+	}
+}
+
+func TestParallelContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int64
+	_, err := ParallelMap(ctx, []int{1, 2, 3}, 2, func(_ context.Context, n int) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		return n, nil
+	})
+	if !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Errorf("ParallelMap() error = %v, calls = %v, want context.Canceled and no calls", err, calls)
+	}
+}
+
+func TestParallelCanceledAfterCompletion(t *testing.T) {
+	for _, mode := range []ErrorMode{FailFast, CollectAll} {
+		ctx, cancel := context.WithCancel(context.Background())
+		result, err := ParallelMap(ctx, []int{1, 2, 3}, 1, func(_ context.Context, n int) (int, error) {
+			if n == 3 {
+				cancel()
+			}
+			return n * 2, nil
+		}, WithErrorMode(mode))
+		if err != nil || !reflect.DeepEqual(result, []int{2, 4, 6}) {
+			t.Errorf("ParallelMap() mode %v = %v, %v, want [2 4 6], nil", mode, result, err)
+		}
+
+		ctx, cancel = context.WithCancel(context.Background())
+		sum, err := ParallelReduce(ctx, []int{1, 2, 3}, 1, 0, func(_ context.Context, acc, n int) (int, error) {
+			if n == 3 {
+				cancel()
+			}
+			return acc + n, nil
+		}, func(a, b int) int { return a + b }, WithErrorMode(mode))
+		if err != nil || sum != 6 {
+			t.Errorf("ParallelReduce() mode %v = %v, %v, want 6, nil", mode, sum, err)
+		}
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	isEven := func(_ context.Context, n int) (bool, error) { return n%2 == 0, nil }
+	result, err := ParallelFilter(context.Background(), []int{1, 2, 3, 4, 5, 6}, 3, isEven)
+	if err != nil || !reflect.DeepEqual(result, []int{2, 4, 6}) {
+		t.Errorf("ParallelFilter() = %v, %v, want [2 4 6], nil", result, err)
+	}
+
+	failing := func(_ context.Context, n int) (bool, error) {
+		if n == 3 {
+			return true, errOdd
+		}
+		return true, nil
+	}
+	result, err = ParallelFilter(context.Background(), []int{1, 2, 3, 4}, 2, failing, WithErrorMode(CollectAll))
+	if !errors.Is(err, errOdd) || !reflect.DeepEqual(result, []int{1, 2, 4}) {
+		t.Errorf("ParallelFilter() = %v, %v, want [1 2 4] and error", result, err)
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	concat := func(_ context.Context, acc string, s string) (string, error) { return acc + s, nil }
+	join := func(a, b string) string { return a + b }
+
+	tests := []struct {
+		name    string
+		input   []string
+		workers int
+	}{
+		{name: "single worker", input: []string{"a", "b", "c", "d", "e"}, workers: 1},
+		{name: "uneven chunks", input: []string{"a", "b", "c", "d", "e"}, workers: 3},
+		{name: "more workers than elements", input: []string{"a", "b"}, workers: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParallelReduce(context.Background(), tt.input, tt.workers, "", concat, join)
+			expected := Reduce(tt.input, "", func(acc, s string) string { return acc + s })
+			if err != nil || result != expected {
+				t.Errorf("ParallelReduce() = %q, %v, want %q", result, err, expected)
+			}
+		})
+	}
+
+	sum := func(_ context.Context, acc, n int) (int, error) {
+		if n%2 != 0 {
+			return acc, errOdd
+		}
+		return acc + n, nil
+	}
+	add := func(a, b int) int { return a + b }
+	result, err := ParallelReduce(context.Background(), []int{1, 2, 3, 4}, 2, 0, sum, add, WithErrorMode(CollectAll))
+	if !errors.Is(err, errOdd) || result != 6 {
+		t.Errorf("ParallelReduce() = %v, %v, want 6 and error", result, err)
+	}
+	result, err = ParallelReduce(context.Background(), []int{1, 2}, 2, 0, sum, add)
+	if !errors.Is(err, errOdd) || result != 0 {
+		t.Errorf("ParallelReduce() = %v, %v, want 0 and error", result, err)
+	}
+	if result, err := ParallelReduce(context.Background(), nil, 2, 7, sum, add); result != 7 || err != nil {
+		t.Errorf("ParallelReduce() on nil slice = %v, %v, want 7, nil", result, err)
+	}
+}
@@ -0,0 +1,221 @@
+package gslice
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// ErrInvalidWeight is returned by [WeightedChoice] and [WeightedSample] when a
+// weight is negative, NaN or infinite, or when all weights are zero.
+var ErrInvalidWeight = fmt.Errorf("invalid weight")
+
+// Source is a source of random numbers. *rand.Rand implements Source.
+type Source interface {
+	Intn(n int) int
+	Float64() float64
+}
+
+// RandomOption configures the random source of a randomized operation.
+type RandomOption func(*randomConfig)
+
+type randomConfig struct {
+	source Source
+}
+
+// WithSource uses source instead of the global math/rand functions.
+//
+// Use a seeded source to make results deterministic:
+//
+//	rng := rand.New(rand.NewSource(42))
+//	picked, ok := Choice(servers, WithSource(rng))
+func WithSource(source Source) RandomOption {
+	return func(c *randomConfig) {
+		c.source = source
+	}
+}
+
+type globalSource struct{}
+
+func (globalSource) Intn(n int) int   { return rand.Intn(n) }
+func (globalSource) Float64() float64 { return rand.Float64() }
+
+func sourceOf(opts []RandomOption) Source {
+	cfg := randomConfig{source: globalSource{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg.source
+}
+
+// Shuffle returns a copy of the slice in random order.
+//
+// Shuffle uses the Fisher-Yates algorithm. The input slice is not modified.
+// If the slice is nil, Shuffle returns nil.
+//
+// Example:
+//
+//	shuffled := Shuffle([]int{1, 2, 3, 4})
+func Shuffle[T any](s []T, opts ...RandomOption) []T {
+	rng := sourceOf(opts)
+	result := clone(s)
+	for i := len(result) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+// Sample returns n elements of the slice chosen at random without replacement.
+//
+// Sample uses reservoir sampling and visits the slice once, so it suits large
+// inputs. The order of the result is random. If n is greater than the length
+// of the slice, all elements are returned in random order. If n is not
+// positive, Sample returns an empty slice.
+//
+// Example:
+//
+//	rng := rand.New(rand.NewSource(1))
+//	picked := Sample(users, 10, WithSource(rng))
+func Sample[T any](s []T, n int, opts ...RandomOption) []T {
+	rng := sourceOf(opts)
+	if n <= 0 {
+		return []T{}
+	}
+	if n > len(s) {
+		n = len(s)
+	}
+	reservoir := make([]T, n)
+	copy(reservoir, s[:n])
+	for i := n; i < len(s); i++ {
+		if j := rng.Intn(i + 1); j < n {
+			reservoir[j] = s[i]
+		}
+	}
+	for i := n - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		reservoir[i], reservoir[j] = reservoir[j], reservoir[i]
+	}
+	return reservoir
+}
+
+// Choice returns a random element of the slice.
+//
+// If the slice is empty, Choice returns the zero value and false.
+//
+// Example:
+//
+//	server, ok := Choice(servers)
+func Choice[T any](s []T, opts ...RandomOption) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s[sourceOf(opts).Intn(len(s))], true
+}
+
+// WeightedChoice returns a random element of the slice, chosen with a
+// probability proportional to the weight returned by weightFn.
+//
+// Elements with weight 0 are never chosen. If the slice is empty,
+// WeightedChoice returns false. Returns [ErrInvalidWeight] if a weight is
+// negative, NaN or infinite, or if all weights are zero.
+//
+// Example:
+//
+//	backend, ok, err := WeightedChoice(backends, func(b Backend) float64 { return b.Weight })
+func WeightedChoice[T any](s []T, weightFn func(T) float64, opts ...RandomOption) (T, bool, error) {
+	var zero T
+	if len(s) == 0 {
+		return zero, false, nil
+	}
+	weights, total, err := collectWeights(s, weightFn)
+	if err != nil {
+		return zero, false, err
+	}
+
+	target := sourceOf(opts).Float64() * total
+	last := 0
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+		last = i
+		if target < w {
+			return s[i], true, nil
+		}
+		target -= w
+	}
+	// Rounding may leave target slightly above the remaining weights.
+	return s[last], true, nil
+}
+
+// WeightedSample returns n elements of the slice chosen at random without
+// replacement, with probabilities proportional to the weight returned by
+// weightFn.
+//
+// WeightedSample uses the Efraimidis-Spirakis reservoir algorithm and visits
+// the slice once. Elements with weight 0 are never chosen, so fewer than n
+// elements may be returned. The result is ordered from the highest to the
+// lowest sampling key. Returns [ErrInvalidWeight] if a weight is negative,
+// NaN or infinite.
+//
+// Example:
+//
+//	picked, err := WeightedSample(ads, 3, func(a Ad) float64 { return a.Bid })
+func WeightedSample[T any](s []T, n int, weightFn func(T) float64, opts ...RandomOption) ([]T, error) {
+	rng := sourceOf(opts)
+	less := func(a, b keyedElem[float64, T]) bool { return a.key > b.key }
+
+	var heap []keyedElem[float64, T]
+	for i, v := range s {
+		w := weightFn(v)
+		if !validWeight(w) {
+			return nil, &IndexError{Index: i, Err: ErrInvalidWeight}
+		}
+		if w == 0 || n <= 0 {
+			continue
+		}
+		// key = u^(1/w), compared in log space for numerical stability.
+		item := keyedElem[float64, T]{key: math.Log(rng.Float64()) / w, value: v}
+		if len(heap) < n {
+			heap = append(heap, item)
+			if len(heap) == n {
+				for j := n/2 - 1; j >= 0; j-- {
+					siftDown(heap, j, less)
+				}
+			}
+		} else if item.key > heap[0].key {
+			heap[0] = item
+			siftDown(heap, 0, less)
+		}
+	}
+
+	sorted := SortStable(heap, less)
+	result := make([]T, len(sorted))
+	for i, item := range sorted {
+		result[i] = item.value
+	}
+	return result, nil
+}
+
+func collectWeights[T any](s []T, weightFn func(T) float64) ([]float64, float64, error) {
+	weights := make([]float64, len(s))
+	var total float64
+	for i, v := range s {
+		w := weightFn(v)
+		if !validWeight(w) {
+			return nil, 0, &IndexError{Index: i, Err: ErrInvalidWeight}
+		}
+		weights[i] = w
+		total += w
+	}
+	if total == 0 || math.IsInf(total, 0) {
+		return nil, 0, ErrInvalidWeight
+	}
+	return weights, total, nil
+}
+
+func validWeight(w float64) bool {
+	return w >= 0 && !math.IsInf(w, 0)
+}
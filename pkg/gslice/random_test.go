@@ -0,0 +1,161 @@
+package gslice
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+func seeded() RandomOption {
+	return WithSource(rand.New(rand.NewSource(42)))
+}
+
+func TestShuffle(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	result := Shuffle(input, seeded())
+	if !reflect.DeepEqual(Sort(result, gvalue.Less[int]), input) {
+		t.Errorf("Shuffle() = %v, not a permutation of %v", result, input)
+	}
+	if !reflect.DeepEqual(result, Shuffle(input, seeded())) {
+		t.Errorf("Shuffle() with the same seed returned different results")
+	}
+	if !reflect.DeepEqual(input, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Shuffle() modified the input slice: %v", input)
+	}
+	if result := Shuffle[int](nil); result != nil {
+		t.Errorf("Shuffle() on nil slice = %v, want nil", result)
+	}
+}
+
+func TestSample(t *testing.T) {
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i
+	}
+
+	tests := []struct {
+		name    string
+		n       int
+		wantLen int
+	}{
+		{name: "subset", n: 10, wantLen: 10},
+		{name: "more than length", n: 200, wantLen: 100},
+		{name: "zero", n: 0, wantLen: 0},
+		{name: "negative", n: -1, wantLen: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Sample(input, tt.n, seeded())
+			if len(result) != tt.wantLen {
+				t.Fatalf("Sample() returned %v elements, want %v", len(result), tt.wantLen)
+			}
+			if len(UniqueComparable(result)) != len(result) {
+				t.Errorf("Sample() = %v contains duplicates", result)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual(Sample(input, 5, seeded()), Sample(input, 5, seeded())) {
+		t.Errorf("Sample() with the same seed returned different results")
+	}
+
+	rng := rand.New(rand.NewSource(7))
+	counts := make([]int, 10)
+	for i := 0; i < 10000; i++ {
+		for _, v := range Sample(input[:10], 3, WithSource(rng)) {
+			counts[v]++
+		}
+	}
+	for v, count := range counts {
+		if count < 2700 || count > 3300 {
+			t.Errorf("Sample() picked %v %v times out of 10000, want about 3000", v, count)
+		}
+	}
+}
+
+func TestChoice(t *testing.T) {
+	if v, ok := Choice([]string{"a", "b", "c"}, seeded()); !ok || IndexOf([]string{"a", "b", "c"}, v) < 0 {
+		t.Errorf("Choice() = %v, %v, want an element and true", v, ok)
+	}
+	if _, ok := Choice([]string{}); ok {
+		t.Errorf("Choice() on empty slice returned ok = true")
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	weights := map[string]float64{"a": 1, "b": 3, "c": 0}
+	input := []string{"a", "b", "c"}
+	weightOf := func(s string) float64 { return weights[s] }
+
+	rng := rand.New(rand.NewSource(3))
+	counts := make(map[string]int)
+	for i := 0; i < 10000; i++ {
+		v, ok, err := WeightedChoice(input, weightOf, WithSource(rng))
+		if !ok || err != nil {
+			t.Fatalf("WeightedChoice() = %v, %v, %v", v, ok, err)
+		}
+		counts[v]++
+	}
+	if counts["c"] != 0 {
+		t.Errorf("WeightedChoice() picked a zero weight element %v times", counts["c"])
+	}
+	if counts["b"] < 7200 || counts["b"] > 7800 {
+		t.Errorf("WeightedChoice() picked b %v times out of 10000, want about 7500", counts["b"])
+	}
+
+	if _, ok, err := WeightedChoice([]string{}, weightOf); ok || err != nil {
+		t.Errorf("WeightedChoice() on empty slice = %v, %v, want false, nil", ok, err)
+	}
+
+	invalid := []struct {
+		name    string
+		weights []float64
+	}{
+		{name: "negative", weights: []float64{1, -1}},
+		{name: "nan", weights: []float64{math.NaN()}},
+		{name: "infinite", weights: []float64{math.Inf(1)}},
+		{name: "all zero", weights: []float64{0, 0}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := WeightedChoice(tt.weights, func(w float64) float64 { return w })
+			if !errors.Is(err, ErrInvalidWeight) {
+				t.Errorf("WeightedChoice() error = %v, want %v", err, ErrInvalidWeight)
+			}
+		})
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	input := []float64{1, 0, 2, 5, 0}
+	identity := func(w float64) float64 { return w }
+
+	result, err := WeightedSample(input, 5, identity, seeded())
+	if err != nil || !reflect.DeepEqual(Sort(result, gvalue.Less[float64]), []float64{1, 2, 5}) {
+		t.Errorf("WeightedSample() = %v, %v, want the non-zero weights", result, err)
+	}
+
+	rng := rand.New(rand.NewSource(5))
+	counts := make(map[float64]int)
+	for i := 0; i < 10000; i++ {
+		picked, _ := WeightedSample([]float64{1, 1, 8}, 1, identity, WithSource(rng))
+		counts[picked[0]]++
+	}
+	if counts[8] < 7700 || counts[8] > 8300 {
+		t.Errorf("WeightedSample() picked weight 8 %v times out of 10000, want about 8000", counts[8])
+	}
+
+	if result, err := WeightedSample(input, 0, identity); err != nil || len(result) != 0 {
+		t.Errorf("WeightedSample() with n = 0 = %v, %v, want [], nil", result, err)
+	}
+	_, err = WeightedSample([]float64{1, -2}, 1, identity)
+	if !errors.Is(err, ErrInvalidWeight) {
+		t.Errorf("WeightedSample() error = %v, want %v", err, ErrInvalidWeight)
+	}
+	checkIndexError(t, "WeightedSample()", err, 1)
+}
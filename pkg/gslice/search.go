@@ -0,0 +1,231 @@
+package gslice
+
+import (
+	"sort"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+// The functions in this file expect slices sorted in ascending order
+// according to less, for example by [Sort]. Two elements a and b are
+// considered equal when neither less(a, b) nor less(b, a) holds.
+
+// LowerBound returns the index of the first element of the sorted slice that
+// is not less than target, or len(s) if there is none.
+//
+// Example:
+//
+//	LowerBound([]int{1, 2, 2, 4}, 2, gvalue.Less[int]) // 1
+func LowerBound[T any](s []T, target T, less func(T, T) bool) int {
+	return sort.Search(len(s), func(i int) bool {
+		return !less(s[i], target)
+	})
+}
+
+// UpperBound returns the index of the first element of the sorted slice that
+// is greater than target, or len(s) if there is none.
+//
+// Example:
+//
+//	UpperBound([]int{1, 2, 2, 4}, 2, gvalue.Less[int]) // 3
+func UpperBound[T any](s []T, target T, less func(T, T) bool) int {
+	return sort.Search(len(s), func(i int) bool {
+		return less(target, s[i])
+	})
+}
+
+// BinarySearch searches for target in the sorted slice and returns the index
+// of its first occurrence and true, or the index where it would be inserted
+// and false.
+//
+// Example:
+//
+//	i, found := BinarySearch([]int{1, 3, 5}, 3, gvalue.Less[int]) // 1, true
+//	i, found = BinarySearch([]int{1, 3, 5}, 4, gvalue.Less[int])  // 2, false
+func BinarySearch[T any](s []T, target T, less func(T, T) bool) (int, bool) {
+	i := LowerBound(s, target, less)
+	return i, i < len(s) && !less(target, s[i])
+}
+
+// BinarySearchBy searches for target in a slice sorted in ascending order of
+// the key returned by keyFn, for example by [SortBy].
+//
+// It returns the index of the first element with the key and true, or the
+// index where such an element would be inserted and false.
+//
+// Example:
+//
+//	users := SortBy(users, func(u User) int { return u.ID })
+//	i, found := BinarySearchBy(users, 42, func(u User) int { return u.ID })
+func BinarySearchBy[T any, K gvalue.Ordered](s []T, target K, keyFn func(T) K) (int, bool) {
+	i := sort.Search(len(s), func(i int) bool {
+		return keyFn(s[i]) >= target
+	})
+	return i, i < len(s) && keyFn(s[i]) == target
+}
+
+// InsertSorted returns a copy of the sorted slice with v inserted at its
+// sorted position, after any equal elements.
+//
+// The original slice is not modified.
+//
+// Example:
+//
+//	InsertSorted([]int{1, 3, 5}, 4, gvalue.Less[int]) // []int{1, 3, 4, 5}
+func InsertSorted[T any](s []T, v T, less func(T, T) bool) []T {
+	i := UpperBound(s, v, less)
+	result := make([]T, len(s)+1)
+	copy(result, s[:i])
+	result[i] = v
+	copy(result[i+1:], s[i:])
+	return result
+}
+
+// MergeSorted merges sorted slices into a new sorted slice.
+//
+// Duplicates are kept. Equal elements keep the order of their slices in the
+// argument list. MergeSorted runs in O(n log k) for n elements in k slices.
+// If no slices are given, MergeSorted returns nil.
+//
+// Example:
+//
+//	MergeSorted(gvalue.Less[int], []int{1, 4}, []int{2, 3}, []int{0, 5})
+//	// []int{0, 1, 2, 3, 4, 5}
+func MergeSorted[T any](less func(T, T) bool, slices ...[]T) []T {
+	if len(slices) == 0 {
+		return nil
+	}
+
+	total := 0
+	for _, s := range slices {
+		total += len(s)
+	}
+	result := make([]T, 0, total)
+
+	// heads holds the indices of the slices that still have elements,
+	// ordered as a min-heap by their current element.
+	pos := make([]int, len(slices))
+	headLess := func(a, b int) bool {
+		x, y := slices[a][pos[a]], slices[b][pos[b]]
+		if less(x, y) {
+			return true
+		}
+		return !less(y, x) && a < b
+	}
+	var heads []int
+	for i, s := range slices {
+		if len(s) > 0 {
+			heads = append(heads, i)
+		}
+	}
+	greater := func(a, b int) bool { return headLess(b, a) }
+	for i := len(heads)/2 - 1; i >= 0; i-- {
+		siftDown(heads, i, greater)
+	}
+
+	for len(heads) > 0 {
+		top := heads[0]
+		result = append(result, slices[top][pos[top]])
+		pos[top]++
+		if pos[top] == len(slices[top]) {
+			heads[0] = heads[len(heads)-1]
+			heads = heads[:len(heads)-1]
+		}
+		siftDown(heads, 0, greater)
+	}
+	return result
+}
+
+// SortedUnion merges sorted slices and removes duplicates.
+//
+// Unlike [Union], it allocates no map and returns the elements in sorted
+// order. The first of several equal elements is kept. SortedUnion is built on
+// [MergeSorted] and runs in O(n log k) for n elements in k slices.
+//
+// Example:
+//
+//	SortedUnion(gvalue.Less[int], []int{1, 2, 3}, []int{2, 3, 4})
+//	// []int{1, 2, 3, 4}
+func SortedUnion[T any](less func(T, T) bool, slices ...[]T) []T {
+	merged := MergeSorted(less, slices...)
+	if merged == nil {
+		return nil
+	}
+	return compactSorted(merged, less)
+}
+
+// SortedIntersection returns the elements that exist in all sorted slices,
+// without duplicates, in linear time.
+//
+// Unlike [Intersection], it allocates no map. Elements are taken from the
+// first slice. If no slices are given, SortedIntersection returns nil.
+//
+// Example:
+//
+//	SortedIntersection(gvalue.Less[int], []int{1, 2, 3, 4}, []int{2, 4, 6})
+//	// []int{2, 4}
+func SortedIntersection[T any](less func(T, T) bool, slices ...[]T) []T {
+	if len(slices) == 0 {
+		return nil
+	}
+	result := compactSorted(append([]T(nil), slices[0]...), less)
+	for _, other := range slices[1:] {
+		kept := result[:0]
+		j := 0
+		for _, v := range result {
+			for j < len(other) && less(other[j], v) {
+				j++
+			}
+			if j < len(other) && !less(v, other[j]) {
+				kept = append(kept, v)
+			}
+		}
+		result = kept
+	}
+	if result == nil {
+		return []T{}
+	}
+	return result
+}
+
+// SortedDifference returns the elements of the sorted slice first that are
+// not in any of the other sorted slices, without duplicates, in linear time.
+//
+// Unlike [Difference], it allocates no map.
+//
+// Example:
+//
+//	SortedDifference(gvalue.Less[int], []int{1, 2, 3, 4}, []int{2}, []int{4, 5})
+//	// []int{1, 3}
+func SortedDifference[T any](less func(T, T) bool, first []T, others ...[]T) []T {
+	result := compactSorted(append([]T{}, first...), less)
+	for _, other := range others {
+		kept := result[:0]
+		j := 0
+		for _, v := range result {
+			for j < len(other) && less(other[j], v) {
+				j++
+			}
+			if j == len(other) || less(v, other[j]) {
+				kept = append(kept, v)
+			}
+		}
+		result = kept
+	}
+	return result
+}
+
+// compactSorted removes consecutive equal elements from the sorted slice in
+// place and returns it.
+func compactSorted[T any](s []T, less func(T, T) bool) []T {
+	if len(s) == 0 {
+		return s
+	}
+	result := s[:1]
+	for _, v := range s[1:] {
+		if less(result[len(result)-1], v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
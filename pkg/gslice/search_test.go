@@ -0,0 +1,168 @@
+package gslice
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
+
+func TestBinarySearch(t *testing.T) {
+	sorted := []int{1, 2, 2, 2, 4, 6}
+
+	tests := []struct {
+		name      string
+		target    int
+		wantIndex int
+		wantFound bool
+		wantLower int
+		wantUpper int
+	}{
+		{name: "first of duplicates", target: 2, wantIndex: 1, wantFound: true, wantLower: 1, wantUpper: 4},
+		{name: "missing middle", target: 3, wantIndex: 4, wantFound: false, wantLower: 4, wantUpper: 4},
+		{name: "before first", target: 0, wantIndex: 0, wantFound: false, wantLower: 0, wantUpper: 0},
+		{name: "after last", target: 7, wantIndex: 6, wantFound: false, wantLower: 6, wantUpper: 6},
+		{name: "last", target: 6, wantIndex: 5, wantFound: true, wantLower: 5, wantUpper: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := BinarySearch(sorted, tt.target, gvalue.Less[int])
+			if index != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("BinarySearch() = %v, %v, want %v, %v", index, found, tt.wantIndex, tt.wantFound)
+			}
+			if lower := LowerBound(sorted, tt.target, gvalue.Less[int]); lower != tt.wantLower {
+				t.Errorf("LowerBound() = %v, want %v", lower, tt.wantLower)
+			}
+			if upper := UpperBound(sorted, tt.target, gvalue.Less[int]); upper != tt.wantUpper {
+				t.Errorf("UpperBound() = %v, want %v", upper, tt.wantUpper)
+			}
+		})
+	}
+
+	if index, found := BinarySearch(nil, 1, gvalue.Less[int]); index != 0 || found {
+		t.Errorf("BinarySearch() on nil slice = %v, %v, want 0, false", index, found)
+	}
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	users := []sortUser{{"amy", 25}, {"bob", 30}, {"cat", 30}, {"dan", 41}}
+	byAge := func(u sortUser) int { return u.Age }
+
+	if index, found := BinarySearchBy(users, 30, byAge); index != 1 || !found {
+		t.Errorf("BinarySearchBy() = %v, %v, want 1, true", index, found)
+	}
+	if index, found := BinarySearchBy(users, 35, byAge); index != 3 || found {
+		t.Errorf("BinarySearchBy() = %v, %v, want 3, false", index, found)
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		value    int
+		expected []int
+	}{
+		{name: "middle", input: []int{1, 3, 5}, value: 4, expected: []int{1, 3, 4, 5}},
+		{name: "front", input: []int{1, 3}, value: 0, expected: []int{0, 1, 3}},
+		{name: "back", input: []int{1, 3}, value: 9, expected: []int{1, 3, 9}},
+		{name: "nil slice", input: nil, value: 1, expected: []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]int(nil), tt.input...)
+			result := InsertSorted(tt.input, tt.value, gvalue.Less[int])
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("InsertSorted() = %v, want %v", result, tt.expected)
+			}
+			if !reflect.DeepEqual(tt.input, original) {
+				t.Errorf("InsertSorted() modified the original slice: %v", tt.input)
+			}
+		})
+	}
+
+	users := InsertSorted([]sortUser{{"amy", 25}, {"bob", 30}}, sortUser{"cat", 30}, func(a, b sortUser) bool { return a.Age < b.Age })
+	if users[2].Name != "cat" {
+		t.Errorf("InsertSorted() = %v, want cat after equal elements", users)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    [][]int
+		expected []int
+	}{
+		{
+			name:     "three slices",
+			input:    [][]int{{1, 4, 7}, {2, 5}, {0, 3, 6, 8}},
+			expected: []int{0, 1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			name:     "keeps duplicates",
+			input:    [][]int{{1, 2}, {1, 2}},
+			expected: []int{1, 1, 2, 2},
+		},
+		{
+			name:     "empty slices",
+			input:    [][]int{{}, nil, {1}},
+			expected: []int{1},
+		},
+		{
+			name:     "no slices",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MergeSorted(gvalue.Less[int], tt.input...)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("MergeSorted() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	byAge := func(a, b sortUser) bool { return a.Age < b.Age }
+	merged := MergeSorted(byAge, []sortUser{{"bob", 30}}, []sortUser{{"amy", 25}, {"cat", 30}})
+	expected := []sortUser{{"amy", 25}, {"bob", 30}, {"cat", 30}}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("MergeSorted() = %v, want %v", merged, expected)
+	}
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	a := []int{1, 2, 2, 3, 5}
+	b := []int{2, 3, 4}
+	c := []int{3, 5, 6}
+
+	tests := []struct {
+		name     string
+		result   []int
+		expected []int
+	}{
+		{name: "union", result: SortedUnion(gvalue.Less[int], a, b, c), expected: []int{1, 2, 3, 4, 5, 6}},
+		{name: "union of nothing", result: SortedUnion[int](gvalue.Less[int]), expected: nil},
+		{name: "intersection", result: SortedIntersection(gvalue.Less[int], a, b), expected: []int{2, 3}},
+		{name: "intersection of three", result: SortedIntersection(gvalue.Less[int], a, b, c), expected: []int{3}},
+		{name: "empty intersection", result: SortedIntersection(gvalue.Less[int], []int{1}, []int{2}), expected: []int{}},
+		{name: "difference", result: SortedDifference(gvalue.Less[int], a, b), expected: []int{1, 5}},
+		{name: "difference of three", result: SortedDifference(gvalue.Less[int], a, []int{1}, c), expected: []int{2}},
+		{name: "difference of nil", result: SortedDifference(gvalue.Less[int], nil, a), expected: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.result, tt.expected) {
+				t.Errorf("%s = %v, want %v", tt.name, tt.result, tt.expected)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual(a, []int{1, 2, 2, 3, 5}) {
+		t.Errorf("sorted set operations modified the input slice: %v", a)
+	}
+}
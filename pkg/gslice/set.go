@@ -6,6 +6,8 @@ package gslice
 // It returns a new slice containing only unique elements, preserving the order
 // of the first occurrence of each element. The original slice is not modified.
 //
+// Unique runs in O(n²). Prefer [UniqueComparable] or [UniqueBy] for large slices.
+//
 // Example:
 //
 //	numbers := []int{1, 2, 2, 3, 3, 3, 4}
@@ -35,13 +37,109 @@ func Unique[T any](s []T, eq func(T, T) bool) []T {
 	return result
 }
 
+// UniqueBy removes elements whose key, as returned by keyFn, was already seen.
+//
+// UniqueBy keeps the first occurrence of each key and preserves the order of
+// the input slice. It runs in O(n) using a hash set of keys. The input slice
+// is not modified.
+//
+// Example:
+//
+//	users := []User{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {ID: 1, Name: "c"}}
+//	unique := UniqueBy(users, func(u User) int { return u.ID })
+//	// unique is []User{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}
+func UniqueBy[T any, K comparable](s []T, keyFn func(T) K) []T {
+	if s == nil {
+		return nil
+	}
+	seen := make(map[K]struct{}, len(s))
+	result := make([]T, 0, len(s))
+	for _, v := range s {
+		key := keyFn(v)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// UniqueComparable removes duplicate elements from a slice of comparable elements.
+//
+// UniqueComparable keeps the first occurrence of each element and runs in
+// O(n). The input slice is not modified.
+//
+// Example:
+//
+//	unique := UniqueComparable([]int{1, 2, 2, 3, 1})
+//	// unique is []int{1, 2, 3}
+func UniqueComparable[T comparable](s []T) []T {
+	return UniqueBy(s, func(v T) T { return v })
+}
+
+// Duplicate describes an element that occurs more than once in a slice.
+type Duplicate[T any] struct {
+	// Value is the first occurrence of the element.
+	Value T
+	// Indices holds the indices of all occurrences in ascending order.
+	Indices []int
+}
+
+// Duplicates reports the elements that occur more than once in a slice.
+//
+// The result is ordered by the first occurrence of each element and is nil
+// if there are no duplicates. Duplicates runs in O(n).
+//
+// Example:
+//
+//	dups := Duplicates([]string{"a", "b", "a", "c", "b", "a"})
+//	// dups is []Duplicate[string]{{"a", []int{0, 2, 5}}, {"b", []int{1, 4}}}
+func Duplicates[T comparable](s []T) []Duplicate[T] {
+	return DuplicatesBy(s, func(v T) T { return v })
+}
+
+// DuplicatesBy reports the elements whose key, as returned by keyFn, occurs
+// more than once in a slice.
+//
+// Value of each [Duplicate] is the first element with the key. The result is
+// ordered by first occurrence and is nil if there are no duplicates.
+//
+// Example:
+//
+//	dups := DuplicatesBy(users, func(u User) string { return u.Email })
+//	for _, d := range dups {
+//	    fmt.Println(d.Value.Email, d.Indices)
+//	}
+func DuplicatesBy[T any, K comparable](s []T, keyFn func(T) K) []Duplicate[T] {
+	positions := make(map[K]int, len(s))
+	var groups []Duplicate[T]
+	for i, v := range s {
+		key := keyFn(v)
+		if pos, ok := positions[key]; ok {
+			groups[pos].Indices = append(groups[pos].Indices, i)
+			continue
+		}
+		positions[key] = len(groups)
+		groups = append(groups, Duplicate[T]{Value: v, Indices: []int{i}})
+	}
+
+	var result []Duplicate[T]
+	for _, group := range groups {
+		if len(group.Indices) > 1 {
+			result = append(result, group)
+		}
+	}
+	return result
+}
+
 // Union merges multiple slices and removes duplicates.
 //
 // Union combines all elements from all input slices and returns a new slice
 // containing only unique elements. The order is preserved based on the first
 // occurrence of each element across all slices. The input slices are not modified.
 //
-// Union requires T to be comparable, allowing efficient duplicate detection using a map.
+// Union requires T to be comparable and is built on [OrderedSet].
 //
 // Example:
 //
@@ -55,32 +153,11 @@ func Union[T comparable](slices ...[]T) []T {
 		return nil
 	}
 
-	// Calculate total capacity
-	totalLen := 0
+	set := NewOrderedSet[T]()
 	for _, s := range slices {
-		totalLen += len(s)
+		set.Add(s...)
 	}
-	if totalLen == 0 {
-		return []T{}
-	}
-
-	// Use a map to track seen elements for O(1) lookup
-	seen := make(map[T]struct{}, totalLen)
-	result := make([]T, 0, totalLen)
-
-	// Iterate through all slices
-	for _, s := range slices {
-		for _, elem := range s {
-			// Check if element has been seen using map lookup
-			if _, exists := seen[elem]; !exists {
-				// Mark as seen and add to result
-				seen[elem] = struct{}{}
-				result = append(result, elem)
-			}
-		}
-	}
-
-	return result
+	return set.ToSlice()
 }
 
 // Intersection returns elements that exist in all input slices.
@@ -89,7 +166,7 @@ func Union[T comparable](slices ...[]T) []T {
 // It returns a new slice containing these common elements, with duplicates
 // removed. The order is based on the first slice. The input slices are not modified.
 //
-// Intersection requires T to be comparable, allowing efficient lookup using maps.
+// Intersection requires T to be comparable and is built on [OrderedSet].
 //
 // Example:
 //
@@ -102,41 +179,15 @@ func Intersection[T comparable](slices ...[]T) []T {
 	if len(slices) == 0 {
 		return nil
 	}
-	if len(slices) == 1 {
-		// Use Union to remove duplicates from a single slice
-		return Union(slices[0])
-	}
-
-	// Count occurrences of each element across all slices
-	// Use a map to count how many slices contain each element
-	countMap := make(map[T]int)
-
-	// Iterate through each slice
-	for _, s := range slices {
-		// Use a map to deduplicate elements within a single slice
-		// to avoid counting the same element multiple times in one slice
-		seenInSlice := make(map[T]struct{})
-		for _, elem := range s {
-			// Only count each element once per slice
-			if _, exists := seenInSlice[elem]; !exists {
-				seenInSlice[elem] = struct{}{}
-				countMap[elem]++
-			}
-		}
-	}
 
-	// Collect elements that appear in all slices (count == number of slices)
-	sliceCount := len(slices)
-	result := make([]T, 0)
-
-	// Iterate through countMap to collect elements that appear in all slices
-	for elem, count := range countMap {
-		if count >= sliceCount {
-			result = append(result, elem)
+	set := NewOrderedSet(slices[0]...)
+	for _, s := range slices[1:] {
+		if set.Len() == 0 {
+			break
 		}
+		set = set.Intersect(NewOrderedSet(s...))
 	}
-
-	return result
+	return set.ToSlice()
 }
 
 // Difference returns elements from the first slice that are not in any of the other slices.
@@ -145,7 +196,7 @@ func Intersection[T comparable](slices ...[]T) []T {
 // It returns a new slice containing these elements, with duplicates removed.
 // The order is based on the first slice. The input slices are not modified.
 //
-// Difference requires T to be comparable, allowing efficient lookup using maps.
+// Difference requires T to be comparable and is built on [OrderedSet].
 //
 // Example:
 //
@@ -155,39 +206,12 @@ func Intersection[T comparable](slices ...[]T) []T {
 //	diff := Difference(s1, s2, s3)
 //	// diff is []int{1, 5}
 func Difference[T comparable](first []T, others ...[]T) []T {
-	if len(first) == 0 {
-		return []T{}
-	}
-	if len(others) == 0 {
-		// Use Union to remove duplicates from a single slice
-		return Union(first)
-	}
-
-	// Build a map of all elements in other slices for O(1) lookup
-	otherSet := make(map[T]struct{})
+	set := NewOrderedSet(first...)
 	for _, other := range others {
-		for _, elem := range other {
-			otherSet[elem] = struct{}{}
+		if set.Len() == 0 {
+			break
 		}
+		set.Remove(other...)
 	}
-
-	// Track seen elements to avoid duplicates
-	seen := make(map[T]struct{}, len(first))
-	result := make([]T, 0, len(first))
-
-	// For each element in the first slice, check if it exists in other slices
-	for _, elem := range first {
-		// Skip if already added
-		if _, exists := seen[elem]; exists {
-			continue
-		}
-
-		// Check if element exists in other slices using map lookup
-		if _, exists := otherSet[elem]; !exists {
-			seen[elem] = struct{}{}
-			result = append(result, elem)
-		}
-	}
-
-	return result
+	return set.ToSlice()
 }
@@ -241,3 +241,110 @@ func TestDifference(t *testing.T) {
 	}
 }
 
+
+func TestSetFunctionsOrder(t *testing.T) {
+	if got := Union([]int{3, 1}, []int{2, 3, 0}); !reflect.DeepEqual(got, []int{3, 1, 2, 0}) {
+		t.Errorf("Union() = %v, want [3 1 2 0]", got)
+	}
+	if got := Intersection([]int{5, 4, 3, 2, 1, 4}, []int{1, 2, 4, 5}, []int{4, 1, 5}); !reflect.DeepEqual(got, []int{5, 4, 1}) {
+		t.Errorf("Intersection() = %v, want [5 4 1]", got)
+	}
+	if got := Difference([]int{5, 4, 3, 2, 1, 5}, []int{4}, []int{2}); !reflect.DeepEqual(got, []int{5, 3, 1}) {
+		t.Errorf("Difference() = %v, want [5 3 1]", got)
+	}
+}
+
+func TestUniqueComparable(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "keeps first occurrence order",
+			input:    []int{3, 1, 3, 2, 1},
+			expected: []int{3, 1, 2},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := UniqueComparable(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("UniqueComparable() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	large := make([]int, 200000)
+	for i := range large {
+		large[i] = i % 1000
+	}
+	if result := UniqueComparable(large); len(result) != 1000 {
+		t.Errorf("UniqueComparable() on large slice returned %v elements, want 1000", len(result))
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	input := []string{"apple", "bob", "avocado", "cat", "banana"}
+	result := UniqueBy(input, func(s string) byte { return s[0] })
+	expected := []string{"apple", "bob", "cat"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("UniqueBy() = %v, want %v", result, expected)
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []Duplicate[string]
+	}{
+		{
+			name:  "reports indices in first occurrence order",
+			input: []string{"b", "a", "b", "c", "a", "b"},
+			expected: []Duplicate[string]{
+				{Value: "b", Indices: []int{0, 2, 5}},
+				{Value: "a", Indices: []int{1, 4}},
+			},
+		},
+		{
+			name:     "no duplicates",
+			input:    []string{"a", "b"},
+			expected: nil,
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Duplicates(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Duplicates() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDuplicatesBy(t *testing.T) {
+	input := []string{"apple", "bob", "avocado", "cat"}
+	result := DuplicatesBy(input, func(s string) byte { return s[0] })
+	expected := []Duplicate[string]{{Value: "apple", Indices: []int{0, 2}}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("DuplicatesBy() = %v, want %v", result, expected)
+	}
+}
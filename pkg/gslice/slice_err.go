@@ -0,0 +1,141 @@
+package gslice
+
+// MapErr transforms each element of the input slice using a function that may fail.
+//
+// MapErr stops at the first error and returns it wrapped in an [IndexError]
+// with the index of the failing element. The input slice is not modified.
+//
+// Example:
+//
+//	numbers, err := MapErr([]string{"1", "2", "x"}, strconv.Atoi)
+//	// numbers is nil, err is "index 2: strconv.Atoi: parsing "x": invalid syntax"
+func MapErr[T, R any](s []T, fn func(T) (R, error)) ([]R, error) {
+	if s == nil {
+		return nil, nil
+	}
+	result := make([]R, len(s))
+	for i, v := range s {
+		r, err := fn(v)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		result[i] = r
+	}
+	return result, nil
+}
+
+// MapCollect transforms each element of the input slice using a function that
+// may fail, calling fn for every element even if some fail.
+//
+// Failed elements hold the zero value of R in the result. All errors are
+// wrapped in an [IndexError] and returned together as a [MultiError].
+//
+// Example:
+//
+//	numbers, err := MapCollect([]string{"1", "x", "3", "y"}, strconv.Atoi)
+//	// numbers is []int{1, 0, 3, 0}, err holds the errors of index 1 and 3
+func MapCollect[T, R any](s []T, fn func(T) (R, error)) ([]R, error) {
+	if s == nil {
+		return nil, nil
+	}
+	result := make([]R, len(s))
+	var errs []error
+	for i, v := range s {
+		r, err := fn(v)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		result[i] = r
+	}
+	return result, joinErrors(errs)
+}
+
+// FilterErr returns a new slice containing only the elements that satisfy a
+// predicate that may fail.
+//
+// FilterErr stops at the first error and returns it wrapped in an [IndexError].
+//
+// Example:
+//
+//	active, err := FilterErr(ids, func(id int) (bool, error) { return store.IsActive(id) })
+func FilterErr[T any](s []T, fn func(T) (bool, error)) ([]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	result := make([]T, 0, len(s))
+	for i, v := range s {
+		ok, err := fn(v)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// ReduceErr reduces the slice to a single value by applying a function that
+// may fail cumulatively.
+//
+// ReduceErr stops at the first error and returns the zero value of R and the
+// error wrapped in an [IndexError].
+//
+// Example:
+//
+//	sum, err := ReduceErr([]string{"1", "2"}, 0, func(acc int, s string) (int, error) {
+//	    n, err := strconv.Atoi(s)
+//	    return acc + n, err
+//	})
+//	// sum is 3
+func ReduceErr[T, R any](s []T, initial R, fn func(R, T) (R, error)) (R, error) {
+	result := initial
+	for i, v := range s {
+		r, err := fn(result, v)
+		if err != nil {
+			var zero R
+			return zero, &IndexError{Index: i, Err: err}
+		}
+		result = r
+	}
+	return result, nil
+}
+
+// FindErr returns the first element that satisfies a predicate that may fail,
+// along with a boolean indicating whether such an element was found.
+//
+// FindErr stops at the first error and returns it wrapped in an [IndexError].
+//
+// Example:
+//
+//	user, found, err := FindErr(users, func(u User) (bool, error) { return acl.IsAdmin(u.ID) })
+func FindErr[T any](s []T, fn func(T) (bool, error)) (T, bool, error) {
+	var zero T
+	for i, v := range s {
+		ok, err := fn(v)
+		if err != nil {
+			return zero, false, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			return v, true, nil
+		}
+	}
+	return zero, false, nil
+}
+
+// ForEachErr calls fn for each element of the slice in order.
+//
+// ForEachErr stops at the first error and returns it wrapped in an [IndexError].
+//
+// Example:
+//
+//	err := ForEachErr(files, os.Remove)
+func ForEachErr[T any](s []T, fn func(T) error) error {
+	for i, v := range s {
+		if err := fn(v); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package gslice
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []string
+		expected  []int
+		wantIndex int
+	}{
+		{
+			name:      "all valid",
+			input:     []string{"1", "2", "3"},
+			expected:  []int{1, 2, 3},
+			wantIndex: -1,
+		},
+		{
+			name:      "stops at first error",
+			input:     []string{"1", "x", "y"},
+			expected:  nil,
+			wantIndex: 1,
+		},
+		{
+			name:      "nil slice",
+			input:     nil,
+			expected:  nil,
+			wantIndex: -1,
+		},
+		{
+			name:      "empty slice",
+			input:     []string{},
+			expected:  []int{},
+			wantIndex: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MapErr(tt.input, strconv.Atoi)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("MapErr() = %v, want %v", result, tt.expected)
+			}
+			checkIndexError(t, "MapErr()", err, tt.wantIndex)
+		})
+	}
+}
+
+func TestMapCollect(t *testing.T) {
+	result, err := MapCollect([]string{"1", "x", "3", "y"}, strconv.Atoi)
+	if !reflect.DeepEqual(result, []int{1, 0, 3, 0}) {
+		t.Errorf("MapCollect() = %v, want [1 0 3 0]", result)
+	}
+	var multi MultiError
+	if !errors.As(err, &multi) || len(multi) != 2 {
+		t.Fatalf("MapCollect() error = %v, want 2 errors", err)
+	}
+	checkIndexError(t, "MapCollect()", multi[0], 1)
+	checkIndexError(t, "MapCollect()", multi[1], 3)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("MapCollect() error = %v, want to match %v", err, strconv.ErrSyntax)
+	}
+
+	if result, err := MapCollect([]string{"4"}, strconv.Atoi); err != nil || !reflect.DeepEqual(result, []int{4}) {
+		t.Errorf("MapCollect() = %v, %v, want [4], nil", result, err)
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	isEven := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+
+	result, err := FilterErr([]string{"1", "2", "4"}, isEven)
+	if err != nil || !reflect.DeepEqual(result, []string{"2", "4"}) {
+		t.Errorf("FilterErr() = %v, %v, want [2 4], nil", result, err)
+	}
+
+	result, err = FilterErr([]string{"2", "x"}, isEven)
+	if result != nil {
+		t.Errorf("FilterErr() = %v, want nil", result)
+	}
+	checkIndexError(t, "FilterErr()", err, 1)
+}
+
+func TestReduceErr(t *testing.T) {
+	sum := func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	}
+
+	if result, err := ReduceErr([]string{"1", "2", "3"}, 10, sum); err != nil || result != 16 {
+		t.Errorf("ReduceErr() = %v, %v, want 16, nil", result, err)
+	}
+
+	result, err := ReduceErr([]string{"1", "x"}, 10, sum)
+	if result != 0 {
+		t.Errorf("ReduceErr() = %v, want 0", result)
+	}
+	checkIndexError(t, "ReduceErr()", err, 1)
+}
+
+func TestFindErr(t *testing.T) {
+	greaterThan2 := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n > 2, err
+	}
+
+	value, found, err := FindErr([]string{"1", "3", "x"}, greaterThan2)
+	if err != nil || !found || value != "3" {
+		t.Errorf("FindErr() = %v, %v, %v, want 3, true, nil", value, found, err)
+	}
+
+	value, found, err = FindErr([]string{"1", "2"}, greaterThan2)
+	if err != nil || found || value != "" {
+		t.Errorf("FindErr() = %v, %v, %v, want \"\", false, nil", value, found, err)
+	}
+
+	_, found, err = FindErr([]string{"x", "3"}, greaterThan2)
+	if found {
+		t.Errorf("FindErr() found = true, want false")
+	}
+	checkIndexError(t, "FindErr()", err, 0)
+}
+
+func TestForEachErr(t *testing.T) {
+	var visited []string
+	err := ForEachErr([]string{"a", "b", "c"}, func(s string) error {
+		if s == "b" {
+			return errOdd
+		}
+		visited = append(visited, s)
+		return nil
+	})
+	if !reflect.DeepEqual(visited, []string{"a"}) {
+		t.Errorf("ForEachErr() visited %v, want [a]", visited)
+	}
+	checkIndexError(t, "ForEachErr()", err, 1)
+}
+
+// checkIndexError fails the test if err is not an IndexError at index want.
+// A negative want expects a nil error.
+func checkIndexError(t *testing.T, name string, err error, want int) {
+	t.Helper()
+	if want < 0 {
+		if err != nil {
+			t.Errorf("%s error = %v, want nil", name, err)
+		}
+		return
+	}
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != want {
+		t.Errorf("%s error = %v, want error at index %d", name, err, want)
+	}
+}
@@ -1,6 +1,10 @@
 package gslice
 
-import "sort"
+import (
+	"sort"
+
+	"github.com/geebos/gocraft/pkg/gvalue"
+)
 
 // Sort sorts a copy of the slice and returns the sorted copy.
 //
@@ -45,3 +49,203 @@ func StealSort[T any](s []T, less func(T, T) bool) []T {
 	})
 	return s
 }
+
+// SortStable sorts a copy of the slice, keeping the original order of equal
+// elements, and returns the sorted copy.
+//
+// The original slice is not modified. If the slice is nil, SortStable returns nil.
+//
+// Example:
+//
+//	users := []User{{"bob", 30}, {"amy", 25}, {"cat", 30}}
+//	sorted := SortStable(users, func(a, b User) bool { return a.Age < b.Age })
+//	// sorted is []User{{"amy", 25}, {"bob", 30}, {"cat", 30}}
+func SortStable[T any](s []T, less func(T, T) bool) []T {
+	if s == nil {
+		return nil
+	}
+	result := make([]T, len(s))
+	copy(result, s)
+	sort.SliceStable(result, func(i, j int) bool {
+		return less(result[i], result[j])
+	})
+	return result
+}
+
+// SortBy sorts a copy of the slice in ascending order of the key returned by
+// keyFn and returns the sorted copy.
+//
+// keyFn is called once per element. The sort is stable. The original slice
+// is not modified. If the slice is nil, SortBy returns nil.
+//
+// Example:
+//
+//	words := []string{"banana", "kiwi", "apple"}
+//	sorted := SortBy(words, func(s string) int { return len(s) })
+//	// sorted is []string{"kiwi", "apple", "banana"}
+func SortBy[T any, K gvalue.Ordered](s []T, keyFn func(T) K) []T {
+	if s == nil {
+		return nil
+	}
+	items := make([]keyedElem[K, T], len(s))
+	for i, v := range s {
+		items[i] = keyedElem[K, T]{key: keyFn(v), value: v}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].key < items[j].key
+	})
+	result := make([]T, len(s))
+	for i, item := range items {
+		result[i] = item.value
+	}
+	return result
+}
+
+// keyedElem pairs an element with a precomputed key.
+//
+// It is declared at package level because Go 1.18 does not support type
+// declarations inside generic functions.
+type keyedElem[K, T any] struct {
+	key   K
+	value T
+}
+
+// SortKey is one key of a multi-key sort, see [SortByKeys].
+//
+// Less reports whether a sorts before b in ascending order. If Descending
+// is true the order of the key is reversed.
+type SortKey[T any] struct {
+	Less       func(a, b T) bool
+	Descending bool
+}
+
+// Asc returns a [SortKey] that sorts by the key returned by keyFn in
+// ascending order.
+func Asc[T any, K gvalue.Ordered](keyFn func(T) K) SortKey[T] {
+	return SortKey[T]{Less: func(a, b T) bool { return keyFn(a) < keyFn(b) }}
+}
+
+// Desc returns a [SortKey] that sorts by the key returned by keyFn in
+// descending order.
+func Desc[T any, K gvalue.Ordered](keyFn func(T) K) SortKey[T] {
+	return SortKey[T]{Less: func(a, b T) bool { return keyFn(a) < keyFn(b) }, Descending: true}
+}
+
+// SortByKeys sorts a copy of the slice by several keys and returns the sorted copy.
+//
+// Elements are compared by the first key, then by the second key if the first
+// is equal, and so on. The sort is stable. The original slice is not modified.
+// If the slice is nil, SortByKeys returns nil.
+//
+// Example:
+//
+//	sorted := SortByKeys(users,
+//	    Desc(func(u User) int { return u.Age }),
+//	    Asc(func(u User) string { return u.Name }),
+//	)
+func SortByKeys[T any](s []T, keys ...SortKey[T]) []T {
+	return SortStable(s, func(a, b T) bool {
+		for _, key := range keys {
+			x, y := a, b
+			if key.Descending {
+				x, y = b, a
+			}
+			if key.Less(x, y) {
+				return true
+			}
+			if key.Less(y, x) {
+				return false
+			}
+		}
+		return false
+	})
+}
+
+// IsSorted reports whether the slice is sorted according to less.
+//
+// Example:
+//
+//	IsSorted([]int{1, 2, 2, 3}, gvalue.Less[int]) // true
+func IsSorted[T any](s []T, less func(T, T) bool) bool {
+	for i := 1; i < len(s); i++ {
+		if less(s[i], s[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// TopK returns the k largest elements of the slice according to less,
+// largest first.
+//
+// TopK uses partial selection and runs in O(n log k) without sorting the
+// whole slice. The order of equal elements is unspecified. If k exceeds the
+// length of the slice, all elements are returned. The original slice is not
+// modified.
+//
+// Example:
+//
+//	top := TopK([]int{5, 1, 9, 3, 7}, 3, gvalue.Less[int])
+//	// top is []int{9, 7, 5}
+func TopK[T any](s []T, k int, less func(T, T) bool) []T {
+	return selectK(s, k, func(a, b T) bool { return less(b, a) })
+}
+
+// BottomK returns the k smallest elements of the slice according to less,
+// smallest first.
+//
+// BottomK behaves like [TopK] with the order reversed.
+//
+// Example:
+//
+//	bottom := BottomK([]int{5, 1, 9, 3, 7}, 2, gvalue.Less[int])
+//	// bottom is []int{1, 3}
+func BottomK[T any](s []T, k int, less func(T, T) bool) []T {
+	return selectK(s, k, less)
+}
+
+// selectK returns the k smallest elements of s according to less in
+// ascending order. It keeps a max-heap of the k smallest elements seen so far.
+func selectK[T any](s []T, k int, less func(T, T) bool) []T {
+	if s == nil {
+		return nil
+	}
+	if k <= 0 {
+		return []T{}
+	}
+	if k > len(s) {
+		k = len(s)
+	}
+
+	h := make([]T, k)
+	copy(h, s[:k])
+	for i := k/2 - 1; i >= 0; i-- {
+		siftDown(h, i, less)
+	}
+	for _, v := range s[k:] {
+		if less(v, h[0]) {
+			h[0] = v
+			siftDown(h, 0, less)
+		}
+	}
+	return StealSort(h, less)
+}
+
+// siftDown restores the max-heap property of h below index i.
+func siftDown[T any](h []T, i int, less func(T, T) bool) {
+	for {
+		largest := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(h) && less(h[largest], h[left]) {
+			largest = left
+		}
+		if right < len(h) && less(h[largest], h[right]) {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		h[i], h[largest] = h[largest], h[i]
+		i = largest
+	}
+}
@@ -173,3 +173,177 @@ func TestStealSortModifiesOriginal(t *testing.T) {
 		// This is expected - the first element changed, but the slice itself is modified
 	}
 }
+
+type sortUser struct {
+	Name string
+	Age  int
+}
+
+func TestSortStable(t *testing.T) {
+	input := []sortUser{{"bob", 30}, {"amy", 25}, {"cat", 30}, {"dan", 25}}
+	result := SortStable(input, func(a, b sortUser) bool { return a.Age < b.Age })
+	expected := []sortUser{{"amy", 25}, {"dan", 25}, {"bob", 30}, {"cat", 30}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("SortStable() = %v, want %v", result, expected)
+	}
+	if input[0].Name != "bob" {
+		t.Errorf("SortStable() modified the original slice: %v", input)
+	}
+	if result := SortStable[int](nil, gvalue.Less[int]); result != nil {
+		t.Errorf("SortStable() = %v, want nil", result)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{
+			name:     "by length",
+			input:    []string{"banana", "kiwi", "apple", "fig", "pear"},
+			expected: []string{"fig", "kiwi", "pear", "apple", "banana"},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			result := SortBy(tt.input, func(s string) int {
+				calls++
+				return len(s)
+			})
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SortBy() = %v, want %v", result, tt.expected)
+			}
+			if calls != len(tt.input) {
+				t.Errorf("SortBy() called keyFn %v times, want %v", calls, len(tt.input))
+			}
+		})
+	}
+}
+
+func TestSortByKeys(t *testing.T) {
+	input := []sortUser{{"bob", 30}, {"amy", 25}, {"cat", 30}, {"abe", 30}}
+	byAge := func(u sortUser) int { return u.Age }
+	byName := func(u sortUser) string { return u.Name }
+
+	tests := []struct {
+		name     string
+		keys     []SortKey[sortUser]
+		expected []sortUser
+	}{
+		{
+			name:     "age desc, name asc",
+			keys:     []SortKey[sortUser]{Desc(byAge), Asc(byName)},
+			expected: []sortUser{{"abe", 30}, {"bob", 30}, {"cat", 30}, {"amy", 25}},
+		},
+		{
+			name:     "age asc, name desc",
+			keys:     []SortKey[sortUser]{Asc(byAge), Desc(byName)},
+			expected: []sortUser{{"amy", 25}, {"cat", 30}, {"bob", 30}, {"abe", 30}},
+		},
+		{
+			name:     "no keys keeps order",
+			keys:     nil,
+			expected: input,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SortByKeys(input, tt.keys...)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SortByKeys() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected bool
+	}{
+		{name: "sorted", input: []int{1, 2, 2, 3}, expected: true},
+		{name: "unsorted", input: []int{1, 3, 2}, expected: false},
+		{name: "nil slice", input: nil, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IsSorted(tt.input, gvalue.Less[int]); result != tt.expected {
+				t.Errorf("IsSorted() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTopK(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      []int
+		k          int
+		wantTop    []int
+		wantBottom []int
+	}{
+		{
+			name:       "partial",
+			input:      []int{5, 1, 9, 3, 7, 9},
+			k:          3,
+			wantTop:    []int{9, 9, 7},
+			wantBottom: []int{1, 3, 5},
+		},
+		{
+			name:       "k larger than slice",
+			input:      []int{2, 1},
+			k:          5,
+			wantTop:    []int{2, 1},
+			wantBottom: []int{1, 2},
+		},
+		{
+			name:       "k zero",
+			input:      []int{2, 1},
+			k:          0,
+			wantTop:    []int{},
+			wantBottom: []int{},
+		},
+		{
+			name:       "nil slice",
+			input:      nil,
+			k:          3,
+			wantTop:    nil,
+			wantBottom: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]int(nil), tt.input...)
+			if result := TopK(tt.input, tt.k, gvalue.Less[int]); !reflect.DeepEqual(result, tt.wantTop) {
+				t.Errorf("TopK() = %v, want %v", result, tt.wantTop)
+			}
+			if result := BottomK(tt.input, tt.k, gvalue.Less[int]); !reflect.DeepEqual(result, tt.wantBottom) {
+				t.Errorf("BottomK() = %v, want %v", result, tt.wantBottom)
+			}
+			if !reflect.DeepEqual(tt.input, original) {
+				t.Errorf("TopK() modified the original slice: %v", tt.input)
+			}
+		})
+	}
+
+	large := make([]int, 10000)
+	for i := range large {
+		large[i] = (i * 7919) % 10000
+	}
+	if result := TopK(large, 3, gvalue.Less[int]); !reflect.DeepEqual(result, []int{9999, 9998, 9997}) {
+		t.Errorf("TopK() on large slice = %v, want [9999 9998 9997]", result)
+	}
+}
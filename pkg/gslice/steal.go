@@ -0,0 +1,126 @@
+package gslice
+
+// The Steal functions in this file modify the input slice in place and return
+// the result, which shares the underlying array of the input. They allocate
+// nothing (except StealUnique's lookup map) and zero the elements past the
+// end of a shrunk result so that the array holds no stale references. Use
+// the returned slice rather than the input after the call.
+
+// StealFilter keeps the elements that satisfy the predicate, in place.
+//
+// StealFilter is the in-place counterpart of [Filter]. If the slice is nil,
+// StealFilter returns nil.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	numbers = StealFilter(numbers, func(n int) bool { return n%2 == 0 })
+//	// numbers is []int{2, 4}
+func StealFilter[T any](s []T, fn func(T) bool) []T {
+	if s == nil {
+		return nil
+	}
+	kept := s[:0]
+	for _, v := range s {
+		if fn(v) {
+			kept = append(kept, v)
+		}
+	}
+	clearTail(s, len(kept))
+	return kept
+}
+
+// StealUnique removes duplicate elements in place, keeping the first
+// occurrence of each element.
+//
+// StealUnique is the in-place counterpart of [UniqueComparable] and runs in
+// O(n). If the slice is nil, StealUnique returns nil.
+//
+// Example:
+//
+//	numbers := []int{3, 1, 3, 2, 1}
+//	numbers = StealUnique(numbers)
+//	// numbers is []int{3, 1, 2}
+func StealUnique[T comparable](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	seen := make(map[T]struct{}, len(s))
+	return StealFilter(s, func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return false
+		}
+		seen[v] = struct{}{}
+		return true
+	})
+}
+
+// StealReverse reverses the slice in place and returns it.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3}
+//	StealReverse(numbers)
+//	// numbers is []int{3, 2, 1}
+func StealReverse[T any](s []T) []T {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+	return s
+}
+
+// StealMap replaces each element of the slice with the result of fn, in place.
+//
+// StealMap is the in-place counterpart of [Map] for functions that keep the
+// element type.
+//
+// Example:
+//
+//	names := []string{"a", "b"}
+//	StealMap(names, strings.ToUpper)
+//	// names is []string{"A", "B"}
+func StealMap[T any](s []T, fn func(T) T) []T {
+	for i, v := range s {
+		s[i] = fn(v)
+	}
+	return s
+}
+
+// StealCompact removes the zero values from the slice in place.
+//
+// Example:
+//
+//	names := []string{"a", "", "b", ""}
+//	names = StealCompact(names)
+//	// names is []string{"a", "b"}
+func StealCompact[T comparable](s []T) []T {
+	var zero T
+	return StealFilter(s, func(v T) bool { return v != zero })
+}
+
+// StealRemoveAt removes the element at index i in place, shifting the
+// following elements left.
+//
+// If i is out of range, StealRemoveAt returns the slice unchanged.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3}
+//	numbers = StealRemoveAt(numbers, 1)
+//	// numbers is []int{1, 3}
+func StealRemoveAt[T any](s []T, i int) []T {
+	if i < 0 || i >= len(s) {
+		return s
+	}
+	copy(s[i:], s[i+1:])
+	clearTail(s, len(s)-1)
+	return s[:len(s)-1]
+}
+
+// clearTail sets the elements of s from index n on to the zero value.
+func clearTail[T any](s []T, n int) {
+	var zero T
+	for i := n; i < len(s); i++ {
+		s[i] = zero
+	}
+}
@@ -0,0 +1,251 @@
+package gslice
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStealFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "keeps evens",
+			input:    []int{1, 2, 3, 4, 5},
+			expected: []int{2, 4},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StealFilter(tt.input, func(n int) bool { return n%2 == 0 })
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("StealFilter() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	a, b := 1, 2
+	ptrs := []*int{&a, &b, &a}
+	result := StealFilter(ptrs, func(p *int) bool { return p == &b })
+	if len(result) != 1 || &result[0] != &ptrs[0] {
+		t.Errorf("StealFilter() did not reuse the input array")
+	}
+	if ptrs[1] != nil || ptrs[2] != nil {
+		t.Errorf("StealFilter() did not zero the tail: %v", ptrs)
+	}
+}
+
+func TestStealUnique(t *testing.T) {
+	input := []string{"b", "a", "b", "c", "a"}
+	result := StealUnique(input)
+	if !reflect.DeepEqual(result, []string{"b", "a", "c"}) {
+		t.Errorf("StealUnique() = %v, want [b a c]", result)
+	}
+	if !reflect.DeepEqual(input, []string{"b", "a", "c", "", ""}) {
+		t.Errorf("StealUnique() input = %q, want zeroed tail", input)
+	}
+	if result := StealUnique[int](nil); result != nil {
+		t.Errorf("StealUnique() = %v, want nil", result)
+	}
+}
+
+func TestStealReverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{name: "odd length", input: []int{1, 2, 3}, expected: []int{3, 2, 1}},
+		{name: "even length", input: []int{1, 2, 3, 4}, expected: []int{4, 3, 2, 1}},
+		{name: "nil slice", input: nil, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StealReverse(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) || !reflect.DeepEqual(tt.input, tt.expected) {
+				t.Errorf("StealReverse() = %v, input %v, want %v", result, tt.input, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStealMap(t *testing.T) {
+	input := []string{"a", "b"}
+	result := StealMap(input, strings.ToUpper)
+	if !reflect.DeepEqual(result, []string{"A", "B"}) || input[0] != "A" {
+		t.Errorf("StealMap() = %v, input %v, want [A B]", result, input)
+	}
+}
+
+func TestStealCompact(t *testing.T) {
+	input := []string{"a", "", "b", ""}
+	result := StealCompact(input)
+	if !reflect.DeepEqual(result, []string{"a", "b"}) {
+		t.Errorf("StealCompact() = %q, want [a b]", result)
+	}
+	if result := StealCompact([]int{0, 0}); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("StealCompact() = %v, want []", result)
+	}
+}
+
+func TestStealRemoveAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		index    int
+		expected []int
+	}{
+		{name: "middle", input: []int{1, 2, 3}, index: 1, expected: []int{1, 3}},
+		{name: "last", input: []int{1, 2, 3}, index: 2, expected: []int{1, 2}},
+		{name: "negative index", input: []int{1, 2}, index: -1, expected: []int{1, 2}},
+		{name: "index out of range", input: []int{1, 2}, index: 2, expected: []int{1, 2}},
+		{name: "nil slice", input: nil, index: 0, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StealRemoveAt(tt.input, tt.index)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("StealRemoveAt() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	input := []int{1, 2, 3}
+	StealRemoveAt(input, 0)
+	if input[2] != 0 {
+		t.Errorf("StealRemoveAt() did not zero the tail: %v", input)
+	}
+}
+
+func benchmarkInput() []int {
+	s := make([]int, 10000)
+	for i := range s {
+		s[i] = i % 1000
+	}
+	return s
+}
+
+func isEvenInt(n int) bool { return n%2 == 0 }
+
+func BenchmarkFilter(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Filter(input, isEvenInt)
+	}
+}
+
+func BenchmarkStealFilter(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		StealFilter(buf, isEvenInt)
+	}
+}
+
+func BenchmarkUniqueComparable(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		UniqueComparable(input)
+	}
+}
+
+func BenchmarkStealUnique(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		StealUnique(buf)
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Map(input, func(n int) int { return n * 2 })
+	}
+}
+
+func BenchmarkStealMap(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		StealMap(input, func(n int) int { return n * 2 })
+	}
+}
+
+func BenchmarkSliceRemove(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Concat(Slice(input, 0, 100), Slice(input, 101, len(input)))
+	}
+}
+
+func BenchmarkStealRemoveAt(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		StealRemoveAt(buf, 100)
+	}
+}
+
+func BenchmarkReverse(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Reverse(input)
+	}
+}
+
+func BenchmarkStealReverse(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		StealReverse(input)
+	}
+}
+
+func isNonZeroInt(n int) bool { return n != 0 }
+
+// BenchmarkCompact measures the copying equivalent of StealCompact.
+func BenchmarkCompact(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Filter(input, isNonZeroInt)
+	}
+}
+
+func BenchmarkStealCompact(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		StealCompact(buf)
+	}
+}
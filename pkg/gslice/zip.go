@@ -0,0 +1,145 @@
+package gslice
+
+import "fmt"
+
+// ErrLengthMismatch is returned by [Zip] and [ZipWith] with [ErrorOnMismatch]
+// when the slices have different lengths.
+var ErrLengthMismatch = fmt.Errorf("length mismatch")
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// LengthPolicy controls how [Zip] and [ZipWith] handle slices of different lengths.
+type LengthPolicy int
+
+const (
+	// Truncate stops at the end of the shorter slice.
+	Truncate LengthPolicy = iota
+	// PadZero continues to the end of the longer slice, using zero values
+	// for the missing elements of the shorter one.
+	PadZero
+	// ErrorOnMismatch returns [ErrLengthMismatch] if the lengths differ.
+	ErrorOnMismatch
+)
+
+// Zip combines the elements of a and b at the same index into pairs.
+//
+// policy decides what happens when a and b have different lengths. If both
+// slices are nil, Zip returns nil.
+//
+// Example:
+//
+//	pairs, err := Zip([]string{"a", "b", "c"}, []int{1, 2}, Truncate)
+//	// pairs is []Pair[string, int]{{"a", 1}, {"b", 2}}
+func Zip[A, B any](a []A, b []B, policy LengthPolicy) ([]Pair[A, B], error) {
+	return ZipWith(a, b, func(x A, y B) Pair[A, B] {
+		return Pair[A, B]{First: x, Second: y}
+	}, policy)
+}
+
+// ZipWith combines the elements of a and b at the same index using fn.
+//
+// policy decides what happens when a and b have different lengths. If both
+// slices are nil, ZipWith returns nil.
+//
+// Example:
+//
+//	sums, err := ZipWith([]int{1, 2}, []int{10, 20}, func(x, y int) int { return x + y }, ErrorOnMismatch)
+//	// sums is []int{11, 22}
+func ZipWith[A, B, R any](a []A, b []B, fn func(A, B) R, policy LengthPolicy) ([]R, error) {
+	if a == nil && b == nil {
+		return nil, nil
+	}
+
+	n := len(a)
+	if len(b) != n {
+		switch policy {
+		case ErrorOnMismatch:
+			return nil, fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(a), len(b))
+		case PadZero:
+			if len(b) > n {
+				n = len(b)
+			}
+		default:
+			if len(b) < n {
+				n = len(b)
+			}
+		}
+	}
+
+	result := make([]R, n)
+	for i := range result {
+		var x A
+		var y B
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		result[i] = fn(x, y)
+	}
+	return result, nil
+}
+
+// Unzip splits pairs into a slice of first values and a slice of second values.
+//
+// If pairs is nil, Unzip returns two nil slices.
+//
+// Example:
+//
+//	names, ages := Unzip([]Pair[string, int]{{"amy", 25}, {"bob", 30}})
+//	// names is []string{"amy", "bob"}, ages is []int{25, 30}
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	if pairs == nil {
+		return nil, nil
+	}
+	first := make([]A, len(pairs))
+	second := make([]B, len(pairs))
+	for i, p := range pairs {
+		first[i] = p.First
+		second[i] = p.Second
+	}
+	return first, second
+}
+
+// Enumerate pairs each element of the slice with its index.
+//
+// Example:
+//
+//	indexed := Enumerate([]string{"a", "b"})
+//	// indexed is []Pair[int, string]{{0, "a"}, {1, "b"}}
+//	odd := Filter(indexed, func(p Pair[int, string]) bool { return p.First%2 == 1 })
+func Enumerate[T any](s []T) []Pair[int, T] {
+	if s == nil {
+		return nil
+	}
+	result := make([]Pair[int, T], len(s))
+	for i, v := range s {
+		result[i] = Pair[int, T]{First: i, Second: v}
+	}
+	return result
+}
+
+// Product returns the cartesian product of a and b: every pair of an element
+// of a with an element of b, ordered by a first.
+//
+// Example:
+//
+//	pairs := Product([]string{"x", "y"}, []int{1, 2})
+//	// pairs is []Pair[string, int]{{"x", 1}, {"x", 2}, {"y", 1}, {"y", 2}}
+func Product[A, B any](a []A, b []B) []Pair[A, B] {
+	if a == nil && b == nil {
+		return nil
+	}
+	result := make([]Pair[A, B], 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			result = append(result, Pair[A, B]{First: x, Second: y})
+		}
+	}
+	return result
+}
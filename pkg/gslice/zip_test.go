@@ -0,0 +1,167 @@
+package gslice
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []string
+		b        []int
+		policy   LengthPolicy
+		expected []Pair[string, int]
+		wantErr  bool
+	}{
+		{
+			name:     "equal lengths",
+			a:        []string{"a", "b"},
+			b:        []int{1, 2},
+			policy:   ErrorOnMismatch,
+			expected: []Pair[string, int]{{"a", 1}, {"b", 2}},
+		},
+		{
+			name:     "truncate",
+			a:        []string{"a", "b", "c"},
+			b:        []int{1, 2},
+			policy:   Truncate,
+			expected: []Pair[string, int]{{"a", 1}, {"b", 2}},
+		},
+		{
+			name:     "pad zero",
+			a:        []string{"a"},
+			b:        []int{1, 2, 3},
+			policy:   PadZero,
+			expected: []Pair[string, int]{{"a", 1}, {"", 2}, {"", 3}},
+		},
+		{
+			name:     "error on mismatch",
+			a:        []string{"a"},
+			b:        []int{1, 2},
+			policy:   ErrorOnMismatch,
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "both nil",
+			a:        nil,
+			b:        nil,
+			policy:   Truncate,
+			expected: nil,
+		},
+		{
+			name:     "one nil",
+			a:        []string{"a"},
+			b:        nil,
+			policy:   Truncate,
+			expected: []Pair[string, int]{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Zip(tt.a, tt.b, tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Zip() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrLengthMismatch) {
+				t.Errorf("Zip() error = %v, want %v", err, ErrLengthMismatch)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Zip() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	add := func(x, y int) int { return x + y }
+	result, err := ZipWith([]int{1, 2, 3}, []int{10, 20}, add, PadZero)
+	if err != nil || !reflect.DeepEqual(result, []int{11, 22, 3}) {
+		t.Errorf("ZipWith() = %v, %v, want [11 22 3], nil", result, err)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	names, ages := Unzip([]Pair[string, int]{{"amy", 25}, {"bob", 30}})
+	if !reflect.DeepEqual(names, []string{"amy", "bob"}) || !reflect.DeepEqual(ages, []int{25, 30}) {
+		t.Errorf("Unzip() = %v, %v, want [amy bob], [25 30]", names, ages)
+	}
+
+	names, ages = Unzip[string, int](nil)
+	if names != nil || ages != nil {
+		t.Errorf("Unzip() on nil = %v, %v, want nil, nil", names, ages)
+	}
+
+	pairs, _ := Zip([]string{"x", "y"}, []int{1, 2}, ErrorOnMismatch)
+	if first, _ := Unzip(pairs); !reflect.DeepEqual(first, []string{"x", "y"}) {
+		t.Errorf("Unzip(Zip()) = %v, want [x y]", first)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []Pair[int, string]
+	}{
+		{
+			name:     "indexes elements",
+			input:    []string{"a", "b"},
+			expected: []Pair[int, string]{{0, "a"}, {1, "b"}},
+		},
+		{
+			name:     "nil slice",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Enumerate(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Enumerate() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []string
+		b        []int
+		expected []Pair[string, int]
+	}{
+		{
+			name:     "two by two",
+			a:        []string{"x", "y"},
+			b:        []int{1, 2},
+			expected: []Pair[string, int]{{"x", 1}, {"x", 2}, {"y", 1}, {"y", 2}},
+		},
+		{
+			name:     "one empty",
+			a:        []string{"x"},
+			b:        []int{},
+			expected: []Pair[string, int]{},
+		},
+		{
+			name:     "both nil",
+			a:        nil,
+			b:        nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Product(tt.a, tt.b)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Product() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@
 //   - [Integer]: all integer types (signed and unsigned)
 //   - [Float]: all floating-point types
 //   - [Complex]: all complex number types
+//   - [Number]: all integer and floating-point types
 //   - [Ordered]: all types that support ordering operators
 //
 // # Utility Functions
@@ -25,7 +26,23 @@
 //   - [Zero]: returns the zero value of any type
 //   - [Ptr]: creates a pointer to a value
 //   - [Of]: dereferences a pointer
+//   - [OfOr], [OfOrZero]: dereference a pointer with a default for nil
+//   - [PtrIfNonZero]: creates a pointer, or nil for the zero value
+//   - [PtrEqual]: compares the values of two pointers, treating nil as a value
+//   - [Coalesce], [CoalescePtr]: return the first non-zero value or non-nil pointer
+//   - [PtrSlice], [DerefSlice]: convert between []T and []*T
 //   - [IfElse]: ternary operator replacement
 //   - [Equal]: equality comparison
 //   - [Less]: less-than comparison
+//
+// # Optional Values
+//
+// [Option] holds a value or nothing and [Result] holds a value or an error.
+// [FromOk], [FromPtr] and [FromPair] adapt the (T, bool), *T and (T, error)
+// forms used across Go code, and [MapOption], [FlatMapOption] and [MapResult]
+// transform the value only when it is present. Option encodes to JSON as
+// its value or null:
+//
+//	port := FromOk(lookup("port")).OrElse(8080)
+//	n := FromPair(strconv.Atoi(s)).OrElse(0)
 package gvalue
@@ -1,8 +1,12 @@
 package gvalue_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/geebos/gocraft/pkg/gslice"
 	"github.com/geebos/gocraft/pkg/gvalue"
 )
 
@@ -53,6 +57,71 @@ func ExampleOf() {
 	// 42
 }
 
+func ExampleOfOr() {
+	// Dereference optional fields without checking for nil
+	type Config struct {
+		Timeout *int
+		Name    *string
+	}
+	config := Config{Timeout: gvalue.Ptr(10)}
+	fmt.Println(gvalue.OfOr(config.Timeout, 30))
+	fmt.Println(gvalue.OfOr(config.Name, "default"))
+	fmt.Printf("%q\n", gvalue.OfOrZero(config.Name))
+
+	// Output:
+	// 10
+	// default
+	// ""
+}
+
+func ExamplePtrIfNonZero() {
+	fmt.Println(gvalue.PtrIfNonZero("") == nil)
+	fmt.Println(*gvalue.PtrIfNonZero("John"))
+
+	// Output:
+	// true
+	// John
+}
+
+func ExamplePtrEqual() {
+	fmt.Println(gvalue.PtrEqual(gvalue.Ptr(1), gvalue.Ptr(1)))
+	fmt.Println(gvalue.PtrEqual(gvalue.Ptr(1), nil))
+	fmt.Println(gvalue.PtrEqual[int](nil, nil))
+
+	// Output:
+	// true
+	// false
+	// true
+}
+
+func ExampleCoalesce() {
+	// Pick the first non-empty value
+	fmt.Println(gvalue.Coalesce("", "nickname", "name"))
+	fmt.Println(gvalue.Coalesce(0, 0))
+
+	// Pick the first non-nil pointer
+	var override *int
+	fmt.Println(*gvalue.CoalescePtr(override, gvalue.Ptr(30)))
+
+	// Output:
+	// nickname
+	// 0
+	// 30
+}
+
+func ExamplePtrSlice() {
+	ptrs := gvalue.PtrSlice([]int{1, 2})
+	fmt.Println(*ptrs[0], *ptrs[1])
+
+	// Nil pointers become zero values
+	values := gvalue.DerefSlice([]*int{gvalue.Ptr(3), nil})
+	fmt.Println(values)
+
+	// Output:
+	// 1 2
+	// [3 0]
+}
+
 func ExampleIfElse() {
 	// Basic ternary operation
 	a, b := 10, 20
@@ -102,3 +171,145 @@ func ExampleLess() {
 	// true
 	// true
 }
+
+func ExampleOption() {
+	// Wrap a value that may be missing
+	port := gvalue.Some(8080)
+	host := gvalue.None[string]()
+	fmt.Println(port.OrElse(80))
+	fmt.Println(host.OrElse("localhost"))
+
+	// Transform the value only if present
+	addr := gvalue.MapOption(port, strconv.Itoa)
+	fmt.Println(addr.Get())
+
+	// Convert to and from pointers
+	fmt.Println(gvalue.FromPtr[int](nil).IsNone())
+	fmt.Println(*port.ToPtr())
+
+	// Output:
+	// 8080
+	// localhost
+	// 8080 true
+	// true
+	// 8080
+}
+
+func ExampleOption_OrElseGet() {
+	calls := 0
+	fallback := func() int {
+		calls++
+		return 80
+	}
+
+	// fn runs only when the value is missing
+	fmt.Println(gvalue.Some(8080).OrElseGet(fallback), calls)
+	fmt.Println(gvalue.None[int]().OrElseGet(fallback), calls)
+
+	// Output:
+	// 8080 0
+	// 80 1
+}
+
+func ExampleFlatMapOption() {
+	type User struct {
+		Name    string
+		Manager *string
+	}
+	manager := func(u User) gvalue.Option[string] { return gvalue.FromPtr(u.Manager) }
+
+	alice := gvalue.Some(User{Name: "alice", Manager: gvalue.Ptr("bob")})
+	bob := gvalue.Some(User{Name: "bob"})
+	fmt.Println(gvalue.FlatMapOption(alice, manager).Get())
+	fmt.Println(gvalue.FlatMapOption(bob, manager).IsNone())
+	fmt.Println(gvalue.FlatMapOption(gvalue.None[User](), manager).IsNone())
+
+	// Output:
+	// bob true
+	// true
+	// true
+}
+
+func ExampleFromOk() {
+	// Wrap the (T, bool) result of gslice.Find
+	numbers := []int{1, 2, 3, 4, 5}
+	even := gvalue.FromOk(gslice.Find(numbers, func(n int) bool { return n%2 == 0 }))
+	large := gvalue.FromOk(gslice.Find(numbers, func(n int) bool { return n > 10 }))
+	fmt.Println(even.Get())
+	fmt.Println(large.Get())
+
+	// Output:
+	// 2 true
+	// 0 false
+}
+
+func ExampleOption_MarshalJSON() {
+	type Patch struct {
+		Name gvalue.Option[string] `json:"name"`
+		Age  gvalue.Option[int]    `json:"age"`
+	}
+
+	data, _ := json.Marshal(Patch{Name: gvalue.Some("John")})
+	fmt.Println(string(data))
+
+	var patch Patch
+	_ = json.Unmarshal([]byte(`{"name":null,"age":30}`), &patch)
+	fmt.Println(patch.Name.IsSome(), patch.Age.OrElse(0))
+
+	// Output:
+	// {"name":"John","age":null}
+	// false 30
+}
+
+func ExampleOption_UnmarshalJSON() {
+	opt := gvalue.Some(1)
+	err := json.Unmarshal([]byte(`"x"`), &opt)
+	fmt.Println(err != nil)
+
+	// The option is left unchanged on error
+	fmt.Println(opt.Get())
+
+	// Output:
+	// true
+	// 1 true
+}
+
+func ExampleResult() {
+	// Wrap a (T, error) return value
+	ok := gvalue.FromPair(strconv.Atoi("42"))
+	bad := gvalue.FromPair(strconv.Atoi("x"))
+	fmt.Println(ok.Unwrap())
+	fmt.Println(bad.IsErr(), bad.OrElse(-1))
+
+	// Transform the value only on success
+	doubled := gvalue.MapResult(ok, func(n int) int { return n * 2 })
+	fmt.Println(doubled.Get())
+	fmt.Println(gvalue.MapResult(bad, func(n int) int { return n * 2 }).Err())
+
+	// Output:
+	// 42
+	// true -1
+	// 84 <nil>
+	// strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleResult_Unwrap() {
+	defer func() {
+		fmt.Println("recovered:", recover())
+	}()
+
+	r := gvalue.Err[int](errors.New("not found"))
+	fmt.Println(r.Unwrap())
+
+	// Output:
+	// recovered: gvalue: Unwrap called on error result: not found
+}
+
+func ExampleErr() {
+	// A nil error yields a successful Result holding the zero value
+	r := gvalue.Err[int](nil)
+	fmt.Println(r.IsOk(), r.Unwrap())
+
+	// Output:
+	// true 0
+}
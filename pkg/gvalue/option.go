@@ -0,0 +1,142 @@
+package gvalue
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Option holds either a value of type T (Some) or no value (None).
+//
+// The zero value is None. Option encodes to JSON as its value or null, so it
+// can describe optional fields without pointers:
+//
+//	type Patch struct {
+//	    Name Option[string] `json:"name"`
+//	}
+//
+// Note that encoding/json never omits struct values, so omitempty has no
+// effect on Option fields.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Option holding v.
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+// None returns an Option holding no value.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromOk returns Some(v) if ok is true and None otherwise.
+//
+// FromOk adapts the (T, bool) results of map lookups and functions such as
+// gslice.Find:
+//
+//	user := FromOk(gslice.Find(users, isAdmin))
+func FromOk[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// FromPtr returns None if p is nil and Some(*p) otherwise.
+func FromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// IsSome reports whether o holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether o holds no value.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true, or the zero value and false for None.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// OrElse returns the value, or def for None.
+func (o Option[T]) OrElse(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.value
+}
+
+// OrElseGet returns the value, or the result of fn for None.
+// fn is only called when o is None.
+func (o Option[T]) OrElseGet(fn func() T) T {
+	if !o.ok {
+		return fn()
+	}
+	return o.value
+}
+
+// ToPtr returns a pointer to a copy of the value, or nil for None.
+func (o Option[T]) ToPtr() *T {
+	if !o.ok {
+		return nil
+	}
+	return Ptr(o.value)
+}
+
+// MarshalJSON encodes the value, or null for None.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// MapOption returns Some(fn(v)) if o holds v, and None otherwise.
+//
+// MapOption is a function rather than a method because methods cannot
+// introduce the result type R.
+//
+// Example:
+//
+//	name := MapOption(user, func(u User) string { return u.Name })
+func MapOption[T, R any](o Option[T], fn func(T) R) Option[R] {
+	if !o.ok {
+		return None[R]()
+	}
+	return Some(fn(o.value))
+}
+
+// FlatMapOption returns fn(v) if o holds v, and None otherwise.
+//
+// Example:
+//
+//	manager := FlatMapOption(user, func(u User) Option[User] { return FromPtr(u.Manager) })
+func FlatMapOption[T, R any](o Option[T], fn func(T) Option[R]) Option[R] {
+	if !o.ok {
+		return None[R]()
+	}
+	return fn(o.value)
+}
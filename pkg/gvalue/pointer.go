@@ -23,9 +23,114 @@ func Ptr[T any](v T) *T {
 // Of panics if v is nil. Use this function only when you are certain
 // that the pointer is not nil.
 //
-// For safe dereferencing with a default value, consider using IfElse:
-//
-//	value := IfElse(ptr != nil, *ptr, defaultValue)
+// For safe dereferencing with a default value, use [OfOr] or [OfOrZero].
+// Note that IfElse(ptr != nil, *ptr, def) does not help, because *ptr is
+// evaluated before IfElse is called.
 func Of[T any](v *T) T {
 	return *v
 }
+
+// OfOr dereferences a pointer and returns its underlying value, or def if v
+// is nil.
+//
+// Example:
+//
+//	timeout := OfOr(config.Timeout, 30)
+func OfOr[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+// OfOrZero dereferences a pointer and returns its underlying value, or the
+// zero value of T if v is nil.
+func OfOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+// PtrIfNonZero returns a pointer to a copy of v, or nil if v is the zero
+// value of T.
+//
+// This is useful for filling optional fields that are omitted when unset:
+//
+//	req := &UpdateRequest{Name: PtrIfNonZero(form.Name)}
+func PtrIfNonZero[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// PtrEqual reports whether l and r are both nil or point to equal values.
+func PtrEqual[T comparable](l, r *T) bool {
+	if l == nil || r == nil {
+		return l == r
+	}
+	return *l == *r
+}
+
+// Coalesce returns the first argument that is not the zero value of T, or
+// the zero value if there is none.
+//
+// Example:
+//
+//	name := Coalesce(user.Nickname, user.Name, "anonymous")
+func Coalesce[T comparable](vals ...T) T {
+	var zero T
+	for _, v := range vals {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
+
+// CoalescePtr returns the first non-nil pointer, or nil if there is none.
+//
+// Example:
+//
+//	timeout := OfOr(CoalescePtr(req.Timeout, config.Timeout), 30)
+func CoalescePtr[T any](ptrs ...*T) *T {
+	for _, p := range ptrs {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// PtrSlice returns a slice of pointers to copies of the elements of s.
+//
+// Like [Ptr], the pointers do not alias the elements of s. PtrSlice returns
+// nil if s is nil.
+func PtrSlice[T any](s []T) []*T {
+	if s == nil {
+		return nil
+	}
+	result := make([]*T, len(s))
+	for i := range s {
+		result[i] = Ptr(s[i])
+	}
+	return result
+}
+
+// DerefSlice returns the values pointed to by the elements of s.
+//
+// Nil pointers become the zero value of T, so the result has the same
+// length as s. DerefSlice returns nil if s is nil.
+func DerefSlice[T any](s []*T) []T {
+	if s == nil {
+		return nil
+	}
+	result := make([]T, len(s))
+	for i, p := range s {
+		result[i] = OfOrZero(p)
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+package gvalue
+
+import "fmt"
+
+// Result holds either a value of type T or an error.
+//
+// Result is useful for storing the outcome of a call, for example when
+// collecting the results of concurrent work:
+//
+//	results := make([]Result[User], len(ids))
+//	for i, id := range ids {
+//	    results[i] = FromPair(client.GetUser(ctx, id))
+//	}
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a Result holding err. If err is nil, the Result holds the
+// zero value of T.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// FromPair returns a Result holding err if it is not nil, and v otherwise.
+//
+// FromPair wraps the (T, error) results of ordinary Go functions:
+//
+//	r := FromPair(strconv.Atoi(s))
+func FromPair[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// IsOk reports whether r holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether r holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and nil, or the zero value and the error.
+func (r Result[T]) Get() (T, error) {
+	if r.err != nil {
+		var zero T
+		return zero, r.err
+	}
+	return r.value, nil
+}
+
+// Err returns the error, or nil if r holds a value.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value.
+//
+// Unwrap panics if r holds an error. Use it only when the error has already
+// been checked; otherwise use [Result.Get] or [Result.OrElse].
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("gvalue: Unwrap called on error result: %v", r.err))
+	}
+	return r.value
+}
+
+// OrElse returns the value, or def if r holds an error.
+func (r Result[T]) OrElse(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+
+// Option returns Some with the value, or None if r holds an error.
+func (r Result[T]) Option() Option[T] {
+	if r.err != nil {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// MapResult returns Ok(fn(v)) if r holds v, and the error of r otherwise.
+//
+// Example:
+//
+//	name := MapResult(FromPair(client.GetUser(ctx, id)), func(u User) string { return u.Name })
+func MapResult[T, R any](r Result[T], fn func(T) R) Result[R] {
+	if r.err != nil {
+		return Err[R](r.err)
+	}
+	return Ok(fn(r.value))
+}
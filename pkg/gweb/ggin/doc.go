@@ -22,6 +22,17 @@
 //	    ggin.WithResponseProcessor(customRespProcessor),
 //	)
 //
+// # Decoding Untrusted JSON
+//
+// Use [JSONRequestProcessor] to decode request bodies with gjson decode limits:
+//
+//	wrapper := ggin.NewHandlerWrapper(
+//	    ggin.WithRequestProcessor(ggin.JSONRequestProcessor(
+//	        gjson.WithMaxBytes(1<<20),
+//	        gjson.WithMaxDepth(32),
+//	    )),
+//	)
+//
 // # Recommended Usage
 //
 // Define handlers in a separate package (e.g., user package):
@@ -2,9 +2,13 @@ package ggin
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/geebos/gocraft/pkg/gjson"
 )
 
 // RequestProcessor is a function type for processing requests with Gin context.
@@ -39,6 +43,37 @@ func defaultRequestProcessor(ctx context.Context, c *gin.Context, req any) error
 	return nil
 }
 
+// JSONRequestProcessor returns a request processor that decodes the JSON request body
+// with gjson using the given decode options, then validates it with gin's binding validator.
+//
+// Use it with decode limits to protect handlers from untrusted payloads:
+//
+//	wrapper := NewHandlerWrapper(
+//		WithRequestProcessor(JSONRequestProcessor(
+//			gjson.WithMaxBytes(1<<20),
+//			gjson.WithMaxDepth(32),
+//		)),
+//	)
+func JSONRequestProcessor(opts ...gjson.DecodeOption) RequestProcessor {
+	return func(ctx context.Context, c *gin.Context, req any) error {
+		// Check if req is interface{}, if so skip decoding
+		if _, isInterface := req.(*interface{}); isInterface {
+			return nil
+		}
+
+		if c.Request == nil || c.Request.Body == nil {
+			return errors.New("invalid request")
+		}
+		if err := gjson.Decode(c.Request.Body, req, opts...); err != nil {
+			return err
+		}
+		if binding.Validator == nil {
+			return nil
+		}
+		return binding.Validator.ValidateStruct(req)
+	}
+}
+
 // defaultResponseProcessor is the default response processor that returns JSON response
 // in the format {code, data, msg}. When err != nil, code is set to 503 and msg is set to err.Error().
 func defaultResponseProcessor(ctx context.Context, c *gin.Context, resp interface{}, err error) {
@@ -11,6 +11,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/geebos/gocraft/pkg/gjson"
 )
 
 func TestHandler(t *testing.T) {
@@ -154,6 +156,61 @@ func TestHandler(t *testing.T) {
 			So(response["data"], ShouldBeNil)
 		})
 
+		Convey("json request processor with limits", func() {
+			wrapper := NewHandlerWrapper(
+				WithRequestProcessor(JSONRequestProcessor(gjson.WithMaxStringLen(8))),
+			)
+			handler := Handler(
+				wrapper,
+				func(ctx context.Context, c *gin.Context, req *TestRequest) (*TestResponse, error) {
+					return &TestResponse{ID: 1, Name: req.Name, Email: req.Email}, nil
+				},
+			)
+
+			Convey("within limits", func() {
+				body := `{"name":"John","email":"j@ex.com"}`
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(body))
+
+				handler(ctx)
+
+				So(w.Code, ShouldEqual, http.StatusOK)
+				var response map[string]interface{}
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				So(err, ShouldBeNil)
+				data, ok := response["data"].(map[string]interface{})
+				So(ok, ShouldBeTrue)
+				So(data["name"], ShouldEqual, "John")
+			})
+
+			Convey("limit exceeded", func() {
+				body := `{"name":"John","email":"john@example.com"}`
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(body))
+
+				handler(ctx)
+
+				So(w.Code, ShouldEqual, http.StatusServiceUnavailable)
+				var response map[string]interface{}
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				So(err, ShouldBeNil)
+				So(response["msg"], ShouldEqual, "`email` string length limit exceeded: max 8")
+			})
+
+			Convey("validation error", func() {
+				body := `{"name":"John"}`
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(body))
+
+				handler(ctx)
+
+				So(w.Code, ShouldEqual, http.StatusServiceUnavailable)
+			})
+		})
+
 		Convey("custom response processor", func() {
 			wrapper := NewHandlerWrapper(
 				WithResponseProcessor(func(ctx context.Context, c *gin.Context, resp any, err error) {